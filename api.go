@@ -0,0 +1,105 @@
+package resend
+
+// API is an interface-typed facade over the whole Client, including the
+// nested sub-services. Code depending on API instead of *Client can be unit
+// tested with the fakes of the resendmock package.
+//
+//	func NewNotifier(api resend.API) *Notifier
+//
+//	notifier := NewNotifier(client.API())
+type API interface {
+	Emails() EmailsAPI
+	Batch() BatchSvc
+	ApiKeys() ApiKeysSvc
+	Domains() DomainsSvc
+	DomainClaims() DomainClaimsSvc
+	Segments() SegmentsSvc
+	// Deprecated: Use Segments instead. Audiences have been renamed to Segments.
+	Audiences() AudiencesSvc
+	Contacts() ContactsAPI
+	ContactProperties() ContactPropertiesSvc
+	Broadcasts() BroadcastsSvc
+	Templates() TemplatesSvc
+	Topics() TopicsSvc
+	Webhooks() WebhooksSvc
+	Logs() LogsSvc
+	Automations() AutomationsSvc
+	Events() EventsSvc
+	OAuthGrants() OAuthGrantsSvc
+	Suppressions() SuppressionsAPI
+}
+
+// EmailsAPI is the Emails service with its received emails sub-service
+type EmailsAPI interface {
+	EmailsSvc
+	Receiving() ReceivingSvc
+}
+
+// ContactsAPI is the Contacts service with its sub-services
+type ContactsAPI interface {
+	ContactsSvc
+	Topics() ContactTopicsSvc
+	Segments() ContactSegmentsSvc
+	Properties() ContactPropertiesSvc
+	Imports() ContactImportsSvc
+}
+
+// SuppressionsAPI is the Suppressions service with its batch sub-service
+type SuppressionsAPI interface {
+	SuppressionsSvc
+	Batch() SuppressionsBatchSvc
+}
+
+// API returns the client as an API. The services are read from the client
+// fields on every call, so replacing a field is reflected by the facade.
+func (c *Client) API() API {
+	return clientAPI{c}
+}
+
+// clientAPI implements API over the fields of a Client
+type clientAPI struct {
+	c *Client
+}
+
+func (a clientAPI) Emails() EmailsAPI                       { return emailsAPI{a.c.Emails} }
+func (a clientAPI) Batch() BatchSvc                         { return a.c.Batch }
+func (a clientAPI) ApiKeys() ApiKeysSvc                     { return a.c.ApiKeys }
+func (a clientAPI) Domains() DomainsSvc                     { return a.c.Domains }
+func (a clientAPI) DomainClaims() DomainClaimsSvc           { return a.c.DomainClaims }
+func (a clientAPI) Segments() SegmentsSvc                   { return a.c.Segments }
+func (a clientAPI) Audiences() AudiencesSvc                 { return a.c.Audiences }
+func (a clientAPI) Contacts() ContactsAPI                   { return contactsAPI{a.c.Contacts} }
+func (a clientAPI) ContactProperties() ContactPropertiesSvc { return a.c.ContactProperties }
+func (a clientAPI) Broadcasts() BroadcastsSvc               { return a.c.Broadcasts }
+func (a clientAPI) Templates() TemplatesSvc                 { return a.c.Templates }
+func (a clientAPI) Topics() TopicsSvc                       { return a.c.Topics }
+func (a clientAPI) Webhooks() WebhooksSvc                   { return a.c.Webhooks }
+func (a clientAPI) Logs() LogsSvc                           { return a.c.Logs }
+func (a clientAPI) Automations() AutomationsSvc             { return a.c.Automations }
+func (a clientAPI) Events() EventsSvc                       { return a.c.Events }
+func (a clientAPI) OAuthGrants() OAuthGrantsSvc             { return a.c.OAuthGrants }
+func (a clientAPI) Suppressions() SuppressionsAPI           { return suppressionsAPI{a.c.Suppressions} }
+
+// emailsAPI exposes the Receiving field of EmailsSvcImpl as a method
+type emailsAPI struct {
+	*EmailsSvcImpl
+}
+
+func (e emailsAPI) Receiving() ReceivingSvc { return e.EmailsSvcImpl.Receiving }
+
+// contactsAPI exposes the sub-service fields of ContactsSvcImpl as methods
+type contactsAPI struct {
+	*ContactsSvcImpl
+}
+
+func (c contactsAPI) Topics() ContactTopicsSvc         { return c.ContactsSvcImpl.Topics }
+func (c contactsAPI) Segments() ContactSegmentsSvc     { return c.ContactsSvcImpl.Segments }
+func (c contactsAPI) Properties() ContactPropertiesSvc { return c.ContactsSvcImpl.Properties }
+func (c contactsAPI) Imports() ContactImportsSvc       { return c.ContactsSvcImpl.Imports }
+
+// suppressionsAPI exposes the Batch field of SuppressionsSvcImpl as a method
+type suppressionsAPI struct {
+	*SuppressionsSvcImpl
+}
+
+func (s suppressionsAPI) Batch() SuppressionsBatchSvc { return s.SuppressionsSvcImpl.Batch }
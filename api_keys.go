@@ -2,24 +2,53 @@ package resend
 
 import (
 	"context"
+	"iter"
 	"net/http"
 )
 
+// ApiKeyPermission is the access granted to an API key
+type ApiKeyPermission string
+
+const (
+	// ApiKeyPermissionFullAccess can create, delete, get and update any resource
+	ApiKeyPermissionFullAccess ApiKeyPermission = "full_access"
+	// ApiKeyPermissionSendingAccess can only send emails
+	ApiKeyPermissionSendingAccess ApiKeyPermission = "sending_access"
+)
+
+// IsValid reports whether the ApiKeyPermission is one of the known values
+func (p ApiKeyPermission) IsValid() bool {
+	switch p {
+	case ApiKeyPermissionFullAccess, ApiKeyPermissionSendingAccess:
+		return true
+	}
+	return false
+}
+
+// String returns the string representation of the ApiKeyPermission
+func (p ApiKeyPermission) String() string {
+	return string(p)
+}
+
 type CreateApiKeyRequest struct {
-	Name       string `json:"name"`
-	Permission string `json:"permission,omitempty"` // TODO: update permission to type
-	DomainId   string `json:"domain_id,omitempty"`
+	Name       string           `json:"name"`
+	Permission ApiKeyPermission `json:"permission,omitempty"`
+	DomainId   string           `json:"domain_id,omitempty"`
 }
 
 type CreateApiKeyResponse struct {
 	Id    string `json:"id"`
 	Token string `json:"token"`
+
+	unknownFields
 }
 
 type ListApiKeysResponse struct {
 	Object  string   `json:"object"`
 	Data    []ApiKey `json:"data"`
 	HasMore bool     `json:"has_more"`
+
+	unknownFields
 }
 
 type UpdateApiKeyRequest struct {
@@ -29,6 +58,8 @@ type UpdateApiKeyRequest struct {
 type UpdateApiKeyResponse struct {
 	Object string `json:"object"`
 	Id     string `json:"id"`
+
+	unknownFields
 }
 
 type ApiKey struct {
@@ -36,6 +67,8 @@ type ApiKey struct {
 	Name       string  `json:"name"`
 	CreatedAt  string  `json:"created_at"`
 	LastUsedAt *string `json:"last_used_at"`
+
+	unknownFields
 }
 
 type ApiKeysSvc interface {
@@ -44,6 +77,7 @@ type ApiKeysSvc interface {
 	ListWithOptions(ctx context.Context, options *ListOptions) (ListApiKeysResponse, error)
 	ListWithContext(ctx context.Context) (ListApiKeysResponse, error)
 	List() (ListApiKeysResponse, error)
+	All(ctx context.Context, options *ListOptions) iter.Seq2[ApiKey, error]
 	RemoveWithContext(ctx context.Context, apiKeyId string) (bool, error)
 	Remove(apiKeyId string) (bool, error)
 	UpdateWithContext(ctx context.Context, apiKeyId string, params *UpdateApiKeyRequest) (UpdateApiKeyResponse, error)
@@ -118,6 +152,16 @@ func (s *ApiKeysSvcImpl) List() (ListApiKeysResponse, error) {
 	return s.ListWithContext(context.Background())
 }
 
+// All iterates over all API keys, fetching pages lazily
+// https://resend.com/docs/api-reference/api-keys/list-api-keys
+func (s *ApiKeysSvcImpl) All(ctx context.Context, options *ListOptions) iter.Seq2[ApiKey, error] {
+	return paginate(ctx, listCursor(options), func(item ApiKey) string { return item.Id },
+		func(ctx context.Context, cursor ListOptions) ([]ApiKey, bool, error) {
+			resp, err := s.ListWithOptions(ctx, &cursor)
+			return resp.Data, resp.HasMore, err
+		})
+}
+
 // RemoveWithContext deletes a given api key by id
 // https://resend.com/docs/api-reference/api-keys/delete-api-key
 func (s *ApiKeysSvcImpl) RemoveWithContext(ctx context.Context, apiKeyId string) (bool, error) {
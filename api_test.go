@@ -0,0 +1,34 @@
+package resend
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientAPI(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/contacts/c1/topics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"object":"list","has_more":false,"data":[{"id":"t1"}]}`))
+	})
+
+	api := client.API()
+	assert.Same(t, client.Emails, api.Emails().(emailsAPI).EmailsSvcImpl)
+	assert.Equal(t, client.Emails.Receiving, api.Emails().Receiving())
+	assert.Equal(t, client.Contacts.Imports, api.Contacts().Imports())
+	assert.Equal(t, client.Suppressions.Batch, api.Suppressions().Batch())
+	assert.Equal(t, client.Broadcasts, api.Broadcasts())
+
+	topics, err := api.Contacts().Topics().List("c1")
+	assert.NoError(t, err)
+	assert.Equal(t, "t1", topics.Data[0].Id)
+
+	// Replaced services are reflected by the facade
+	client.Contacts.Topics = nil
+	assert.Nil(t, api.Contacts().Topics())
+}
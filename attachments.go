@@ -0,0 +1,254 @@
+package resend
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// base64ChunkSize is the number of raw bytes encoded at once while
+// streaming attachments. It must be a multiple of 3 so that only the last
+// chunk is padded.
+const base64ChunkSize = 3 * 16 * 1024
+
+// streamingBody is a JSON request body in which the content of attachment
+// Readers is base64 encoded while the body is read.
+type streamingBody struct {
+	// segments are the JSON parts around the attachment contents
+	segments [][]byte
+
+	// readers are the attachment Readers, in order of appearance
+	readers []io.Reader
+
+	// offsets are the initial positions of the readers, set when all of
+	// them implement io.Seeker
+	offsets []int64
+
+	// size is the total length of the body, or -1 when unknown
+	size int64
+}
+
+// newStreamingBody returns a streaming body for params when it is a
+// *SendEmailRequest or a []*SendEmailRequest with attachment Readers. It
+// returns nil when params has no Reader to stream. params are not modified.
+func newStreamingBody(params any) (*streamingBody, error) {
+	var reqs []*SendEmailRequest
+	switch p := params.(type) {
+	case *SendEmailRequest:
+		reqs = []*SendEmailRequest{p}
+	case []*SendEmailRequest:
+		reqs = p
+	default:
+		return nil, nil
+	}
+
+	token, err := streamToken()
+	if err != nil {
+		return nil, err
+	}
+
+	// Encode a copy of the requests with a unique token in place of the
+	// content of every Reader attachment
+	body := &streamingBody{}
+	copies := make([]*SendEmailRequest, len(reqs))
+	for i, req := range reqs {
+		copies[i] = req
+		if req == nil || !hasAttachmentReader(req) {
+			continue
+		}
+
+		c := *req
+		c.Attachments = make([]*Attachment, len(req.Attachments))
+		for j, a := range req.Attachments {
+			c.Attachments[j] = a
+			if a == nil || a.Reader == nil {
+				continue
+			}
+			ac := *a
+			ac.streamToken = fmt.Sprintf("%s-%d", token, len(body.readers))
+			c.Attachments[j] = &ac
+			body.readers = append(body.readers, a.Reader)
+		}
+		copies[i] = &c
+	}
+	if len(body.readers) == 0 {
+		return nil, nil
+	}
+
+	var encoded any = copies
+	if _, ok := params.(*SendEmailRequest); ok {
+		encoded = copies[0]
+	}
+	buf := new(bytes.Buffer)
+	if err := json.NewEncoder(buf).Encode(encoded); err != nil {
+		return nil, err
+	}
+
+	// Split the JSON around the tokens
+	rest := buf.Bytes()
+	for i := range body.readers {
+		t := []byte(fmt.Sprintf("%s-%d", token, i))
+		idx := bytes.Index(rest, t)
+		if idx < 0 {
+			return nil, errors.New("[ERROR]: failed to encode attachment stream")
+		}
+		body.segments = append(body.segments, rest[:idx])
+		rest = rest[idx+len(t):]
+	}
+	body.segments = append(body.segments, rest)
+
+	if err := body.measure(); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// hasAttachmentReader reports whether an attachment of req has a Reader
+func hasAttachmentReader(req *SendEmailRequest) bool {
+	for _, a := range req.Attachments {
+		if a != nil && a.Reader != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// streamToken returns a random token unlikely to appear in a request
+func streamToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "resend-attachment-" + hex.EncodeToString(b), nil
+}
+
+// measure records the initial offsets of the readers and computes the
+// size of the body when every reader implements io.Seeker.
+func (b *streamingBody) measure() error {
+	b.size = -1
+
+	offsets := make([]int64, len(b.readers))
+	var size int64
+	for i, r := range b.readers {
+		s, ok := r.(io.Seeker)
+		if !ok {
+			return nil
+		}
+		start, err := s.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil
+		}
+		end, err := s.Seek(0, io.SeekEnd)
+		if err != nil {
+			return nil
+		}
+		if _, err := s.Seek(start, io.SeekStart); err != nil {
+			return err
+		}
+		offsets[i] = start
+		size += int64(base64.StdEncoding.EncodedLen(int(end - start)))
+	}
+	for _, s := range b.segments {
+		size += int64(len(s))
+	}
+
+	b.offsets = offsets
+	b.size = size
+	return nil
+}
+
+// reader returns a reader over the whole body. It can only be called once
+// unless the body is replayable.
+func (b *streamingBody) reader() io.Reader {
+	parts := make([]io.Reader, 0, len(b.segments)+len(b.readers))
+	for i, s := range b.segments {
+		parts = append(parts, bytes.NewReader(s))
+		if i < len(b.readers) {
+			var src io.Reader = b.readers[i]
+			if b.replayable() {
+				src = &seekingReader{src: src.(io.ReadSeeker), pos: b.offsets[i]}
+			}
+			parts = append(parts, newBase64Reader(src))
+		}
+	}
+	return io.MultiReader(parts...)
+}
+
+// replayable reports whether the body can be read again with rewind
+func (b *streamingBody) replayable() bool {
+	return b.offsets != nil
+}
+
+// rewind returns a new reader over the whole body. The readers returned
+// by rewind do not share their position, so reading one of them, ie: to
+// log the body, does not affect the body being sent.
+func (b *streamingBody) rewind() (io.ReadCloser, error) {
+	return io.NopCloser(b.reader()), nil
+}
+
+// seekingReader reads src from its own position, seeking src before every
+// read so that several seekingReaders can read the same src in turn.
+type seekingReader struct {
+	src io.ReadSeeker
+	pos int64
+}
+
+// Read implements io.Reader
+func (r *seekingReader) Read(p []byte) (int, error) {
+	if _, err := r.src.Seek(r.pos, io.SeekStart); err != nil {
+		return 0, err
+	}
+	n, err := r.src.Read(p)
+	r.pos += int64(n)
+	return n, err
+}
+
+// base64Reader base64 encodes the content of src while it is read
+type base64Reader struct {
+	src io.Reader
+	in  []byte
+	buf []byte
+	out []byte
+	err error
+}
+
+// newBase64Reader returns a reader of the standard base64 encoding of src
+func newBase64Reader(src io.Reader) *base64Reader {
+	return &base64Reader{
+		src: src,
+		in:  make([]byte, base64ChunkSize),
+		buf: make([]byte, base64.StdEncoding.EncodedLen(base64ChunkSize)),
+	}
+}
+
+// Read implements io.Reader
+func (r *base64Reader) Read(p []byte) (int, error) {
+	for len(r.out) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+
+		// Only the last chunk can be shorter than base64ChunkSize,
+		// so padding is only added at the end of the content
+		n, err := io.ReadFull(r.src, r.in)
+		if n > 0 {
+			r.out = r.buf[:base64.StdEncoding.EncodedLen(n)]
+			base64.StdEncoding.Encode(r.out, r.in[:n])
+		}
+		switch {
+		case err == io.EOF || err == io.ErrUnexpectedEOF:
+			r.err = io.EOF
+		case err != nil:
+			r.err = err
+		}
+	}
+
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
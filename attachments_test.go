@@ -0,0 +1,229 @@
+package resend
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// onlyReader hides the io.Seeker implementation of a reader
+type onlyReader struct {
+	io.Reader
+}
+
+func TestBase64Reader(t *testing.T) {
+	for _, size := range []int{0, 1, 2, 3, 4, base64ChunkSize - 1, base64ChunkSize, base64ChunkSize + 1, 3*base64ChunkSize + 2} {
+		content := bytes.Repeat([]byte("abcdefg"), size/7+1)[:size]
+		out, err := io.ReadAll(newBase64Reader(bytes.NewReader(content)))
+		assert.NoError(t, err)
+		assert.Equal(t, base64.StdEncoding.EncodeToString(content), string(out), "size %d", size)
+	}
+}
+
+func TestAttachmentMarshalJSON(t *testing.T) {
+	data, err := json.Marshal(&Attachment{Content: []byte("hello"), Filename: "hello.txt"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"content":"aGVsbG8=","filename":"hello.txt"}`, string(data))
+
+	data, err = json.Marshal(&Attachment{Reader: strings.NewReader("hello"), Filename: "hello.txt"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"content":"aGVsbG8=","filename":"hello.txt"}`, string(data))
+}
+
+func TestNewRequestStreamsAttachments(t *testing.T) {
+	client := NewClient("re_123")
+
+	params := &SendEmailRequest{
+		From:    "onboarding@resend.dev",
+		To:      []string{"delivered@resend.dev"},
+		Subject: "Invoices",
+		Attachments: []*Attachment{
+			{Filename: "a.txt", Reader: strings.NewReader("first")},
+			{Filename: "b.txt", Content: []byte("second")},
+			{Filename: "c.txt", Reader: strings.NewReader("third")},
+		},
+	}
+
+	req, err := client.NewRequest(context.Background(), http.MethodPost, "emails", params)
+	assert.NoError(t, err)
+
+	body, err := io.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(body)), req.ContentLength)
+	assert.JSONEq(t, `{
+		"from": "onboarding@resend.dev",
+		"to": ["delivered@resend.dev"],
+		"subject": "Invoices",
+		"attachments": [
+			{"filename": "a.txt", "content": "Zmlyc3Q="},
+			{"filename": "b.txt", "content": "c2Vjb25k"},
+			{"filename": "c.txt", "content": "dGhpcmQ="}
+		]
+	}`, string(body))
+
+	// The params are left untouched
+	assert.Empty(t, params.Attachments[0].streamToken)
+
+	// Seekable readers can be replayed
+	assert.NotNil(t, req.GetBody)
+	replay, err := req.GetBody()
+	assert.NoError(t, err)
+	again, err := io.ReadAll(replay)
+	assert.NoError(t, err)
+	assert.Equal(t, body, again)
+}
+
+func TestNewRequestStreamsUnseekableAttachments(t *testing.T) {
+	client := NewClient("re_123")
+
+	params := []*SendEmailRequest{
+		{To: []string{"a@example.com"}},
+		{To: []string{"b@example.com"}, Attachments: []*Attachment{{Filename: "b.txt", Reader: onlyReader{strings.NewReader("hello")}}}},
+	}
+
+	req, err := client.NewRequest(context.Background(), http.MethodPost, "emails/batch", params)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-1), req.ContentLength)
+	assert.Nil(t, req.GetBody)
+
+	body, err := io.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"to": ["a@example.com"], "from": "", "subject": ""},
+		{"to": ["b@example.com"], "from": "", "subject": "", "attachments": [{"filename": "b.txt", "content": "aGVsbG8="}]}
+	]`, string(body))
+}
+
+func TestSendStreamedAttachmentWithRetries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/emails", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		var params map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&params))
+		attachment := params["attachments"].([]any)[0].(map[string]any)
+		assert.Equal(t, "aGVsbG8=", attachment["content"])
+
+		w.Header().Set("Content-Type", "application/json")
+		if attempts == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(&SendEmailResponse{Id: "1"})
+	})
+
+	client.RetryPolicy = testRetryPolicy()
+
+	sent, err := client.Emails.SendWithOptions(context.Background(), &SendEmailRequest{
+		To:          []string{"delivered@resend.dev"},
+		Attachments: []*Attachment{{Filename: "hello.txt", Reader: strings.NewReader("hello")}},
+	}, &SendEmailOptions{IdempotencyKey: "key"})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", sent.Id)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestSendStreamedAttachmentWithLoggedBodies(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/emails", func(w http.ResponseWriter, r *http.Request) {
+		var params map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&params))
+		attachment := params["attachments"].([]any)[0].(map[string]any)
+		assert.Equal(t, "aGVsbG8=", attachment["content"])
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&SendEmailResponse{Id: "1"})
+	})
+
+	buf := &bytes.Buffer{}
+	client.Logger = slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client.LogOptions = LogOptions{LogBodies: true}
+
+	sent, err := client.Emails.Send(&SendEmailRequest{
+		To:          []string{"delivered@resend.dev"},
+		Attachments: []*Attachment{{Filename: "hello.txt", Reader: bytes.NewReader([]byte("hello"))}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", sent.Id)
+	assert.Contains(t, buf.String(), "request_body")
+}
+
+// benchmarkAttachment is the content of a 10 MB attachment
+var benchmarkAttachment = bytes.Repeat([]byte("%PDF-1.7 resend "), 10<<20/16)
+
+func BenchmarkAttachmentIntArray(b *testing.B) {
+	b.SetBytes(int64(len(benchmarkAttachment)))
+	b.ReportAllocs()
+	for range b.N {
+		data, err := json.Marshal(struct {
+			Content []int `json:"content"`
+		}{Content: BytesToIntArray(benchmarkAttachment)})
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.ReportMetric(float64(len(data)), "body-bytes")
+	}
+}
+
+func BenchmarkAttachmentBase64Content(b *testing.B) {
+	client := NewClient("re_123")
+	params := &SendEmailRequest{Attachments: []*Attachment{{Filename: "invoice.pdf", Content: benchmarkAttachment}}}
+
+	b.SetBytes(int64(len(benchmarkAttachment)))
+	b.ReportAllocs()
+	for range b.N {
+		req, err := client.NewRequest(context.Background(), http.MethodPost, "emails", params)
+		if err != nil {
+			b.Fatal(err)
+		}
+		n, _ := io.Copy(io.Discard, req.Body)
+		b.ReportMetric(float64(n), "body-bytes")
+	}
+}
+
+func BenchmarkAttachmentStream(b *testing.B) {
+	client := NewClient("re_123")
+	r := bytes.NewReader(benchmarkAttachment)
+	params := &SendEmailRequest{Attachments: []*Attachment{{Filename: "invoice.pdf", Reader: r}}}
+
+	b.SetBytes(int64(len(benchmarkAttachment)))
+	b.ReportAllocs()
+	for range b.N {
+		r.Seek(0, io.SeekStart)
+		req, err := client.NewRequest(context.Background(), http.MethodPost, "emails", params)
+		if err != nil {
+			b.Fatal(err)
+		}
+		n, _ := io.Copy(io.Discard, req.Body)
+		b.ReportMetric(float64(n), "body-bytes")
+	}
+}
+
+func TestAttachmentUnmarshalJSON(t *testing.T) {
+	var a Attachment
+	assert.NoError(t, json.Unmarshal([]byte(`{"content":"aGVsbG8=","filename":"hello.txt","content_type":"text/plain","content_id":"img"}`), &a))
+	assert.Equal(t, Attachment{Content: []byte("hello"), Filename: "hello.txt", ContentType: "text/plain", ContentId: "img"}, a)
+
+	var legacy Attachment
+	assert.NoError(t, json.Unmarshal([]byte(`{"content":[104,101,108,108,111],"filename":"hello.txt"}`), &legacy))
+	assert.Equal(t, []byte("hello"), legacy.Content)
+
+	var remote Attachment
+	assert.NoError(t, json.Unmarshal([]byte(`{"path":"https://example.com/a.pdf"}`), &remote))
+	assert.Nil(t, remote.Content)
+	assert.Equal(t, "https://example.com/a.pdf", remote.Path)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"content":"not base64!"}`), &remote))
+}
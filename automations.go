@@ -3,6 +3,7 @@ package resend
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"strings"
@@ -57,6 +58,8 @@ type AutomationStepResponse struct {
 	Key    string             `json:"key"`
 	Type   AutomationStepType `json:"type"`
 	Config map[string]any     `json:"config"`
+
+	unknownFields
 }
 
 type AutomationConnection struct {
@@ -75,6 +78,8 @@ type CreateAutomationRequest struct {
 type CreateAutomationResponse struct {
 	Object string `json:"object"`
 	Id     string `json:"id"`
+
+	unknownFields
 }
 
 type UpdateAutomationRequest struct {
@@ -87,23 +92,31 @@ type UpdateAutomationRequest struct {
 type UpdateAutomationResponse struct {
 	Object string `json:"object"`
 	Id     string `json:"id"`
+
+	unknownFields
 }
 
 type DeleteAutomationResponse struct {
 	Object  string `json:"object"`
 	Id      string `json:"id"`
 	Deleted bool   `json:"deleted"`
+
+	unknownFields
 }
 
 type DuplicateAutomationResponse struct {
 	Object string `json:"object"`
 	Id     string `json:"id"`
+
+	unknownFields
 }
 
 type StopAutomationResponse struct {
 	Object string `json:"object"`
 	Id     string `json:"id"`
 	Status string `json:"status"`
+
+	unknownFields
 }
 
 type AutomationListItem struct {
@@ -112,12 +125,16 @@ type AutomationListItem struct {
 	Status    AutomationStatus `json:"status"`
 	CreatedAt string           `json:"created_at"`
 	UpdatedAt string           `json:"updated_at"`
+
+	unknownFields
 }
 
 type ListAutomationsResponse struct {
 	Object  string               `json:"object"`
 	HasMore bool                 `json:"has_more"`
 	Data    []AutomationListItem `json:"data"`
+
+	unknownFields
 }
 
 type Automation struct {
@@ -129,6 +146,8 @@ type Automation struct {
 	UpdatedAt   string                   `json:"updated_at"`
 	Steps       []AutomationStepResponse `json:"steps"`
 	Connections []AutomationConnection   `json:"connections"`
+
+	unknownFields
 }
 
 type AutomationRunListItem struct {
@@ -137,23 +156,53 @@ type AutomationRunListItem struct {
 	StartedAt   *string             `json:"started_at"`
 	CompletedAt *string             `json:"completed_at"`
 	CreatedAt   string              `json:"created_at"`
+
+	unknownFields
 }
 
 type ListAutomationRunsResponse struct {
 	Object  string                  `json:"object"`
 	HasMore bool                    `json:"has_more"`
 	Data    []AutomationRunListItem `json:"data"`
+
+	unknownFields
+}
+
+// AutomationRunStepStatus is the status of a step of an automation run
+type AutomationRunStepStatus string
+
+const (
+	AutomationRunStepStatusPending   AutomationRunStepStatus = "pending"
+	AutomationRunStepStatusRunning   AutomationRunStepStatus = "running"
+	AutomationRunStepStatusCompleted AutomationRunStepStatus = "completed"
+	AutomationRunStepStatusFailed    AutomationRunStepStatus = "failed"
+	AutomationRunStepStatusSkipped   AutomationRunStepStatus = "skipped"
+	AutomationRunStepStatusCancelled AutomationRunStepStatus = "cancelled"
+)
+
+// IsValid reports whether the AutomationRunStepStatus is one of the known values
+func (s AutomationRunStepStatus) IsValid() bool {
+	switch s {
+	case AutomationRunStepStatusPending, AutomationRunStepStatusRunning, AutomationRunStepStatusCompleted, AutomationRunStepStatusFailed, AutomationRunStepStatusSkipped, AutomationRunStepStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// String returns the string representation of the AutomationRunStepStatus
+func (s AutomationRunStepStatus) String() string {
+	return string(s)
 }
 
 type AutomationRunStep struct {
-	Key         string             `json:"key"`
-	Type        AutomationStepType `json:"type"`
-	Status      string             `json:"status"`
-	StartedAt   *string            `json:"started_at"`
-	CompletedAt *string            `json:"completed_at"`
-	Output      any                `json:"output"`
-	Error       any                `json:"error"`
-	CreatedAt   string             `json:"created_at"`
+	Key         string                  `json:"key"`
+	Type        AutomationStepType      `json:"type"`
+	Status      AutomationRunStepStatus `json:"status"`
+	StartedAt   *string                 `json:"started_at"`
+	CompletedAt *string                 `json:"completed_at"`
+	Output      any                     `json:"output"`
+	Error       any                     `json:"error"`
+	CreatedAt   string                  `json:"created_at"`
 }
 
 type AutomationRun struct {
@@ -164,6 +213,8 @@ type AutomationRun struct {
 	CompletedAt *string             `json:"completed_at"`
 	CreatedAt   string              `json:"created_at"`
 	Steps       []AutomationRunStep `json:"steps"`
+
+	unknownFields
 }
 
 // ListAutomationsOptions contains pagination and filter parameters for listing automations
@@ -190,6 +241,7 @@ type AutomationsSvc interface {
 	ListWithContext(ctx context.Context) (ListAutomationsResponse, error)
 	List() (ListAutomationsResponse, error)
 	ListWithOptions(ctx context.Context, options *ListAutomationsOptions) (ListAutomationsResponse, error)
+	All(ctx context.Context, options *ListAutomationsOptions) iter.Seq2[AutomationListItem, error]
 	UpdateWithContext(ctx context.Context, automationId string, params *UpdateAutomationRequest) (UpdateAutomationResponse, error)
 	Update(automationId string, params *UpdateAutomationRequest) (UpdateAutomationResponse, error)
 	RemoveWithContext(ctx context.Context, automationId string) (DeleteAutomationResponse, error)
@@ -200,6 +252,7 @@ type AutomationsSvc interface {
 	Stop(automationId string) (StopAutomationResponse, error)
 	ListRunsWithContext(ctx context.Context, automationId string, options *ListAutomationRunsOptions) (ListAutomationRunsResponse, error)
 	ListRuns(automationId string) (ListAutomationRunsResponse, error)
+	AllRuns(ctx context.Context, automationId string, options *ListAutomationRunsOptions) iter.Seq2[AutomationRunListItem, error]
 	GetRunWithContext(ctx context.Context, automationId string, runId string) (AutomationRun, error)
 	GetRun(automationId string, runId string) (AutomationRun, error)
 }
@@ -334,6 +387,25 @@ func (s *AutomationsSvcImpl) List() (ListAutomationsResponse, error) {
 	return s.ListWithContext(context.Background())
 }
 
+// All iterates over all Automations, fetching pages lazily.
+// The Limit, After and Before options are used for the first page.
+// https://resend.com/docs/api-reference/automations/list-automations
+func (s *AutomationsSvcImpl) All(ctx context.Context, options *ListAutomationsOptions) iter.Seq2[AutomationListItem, error] {
+	var opts ListAutomationsOptions
+	if options != nil {
+		opts = *options
+	}
+	cursor := ListOptions{Limit: opts.Limit, After: opts.After, Before: opts.Before}
+
+	return paginate(ctx, cursor, func(a AutomationListItem) string { return a.Id },
+		func(ctx context.Context, cursor ListOptions) ([]AutomationListItem, bool, error) {
+			page := opts
+			page.Limit, page.After, page.Before = cursor.Limit, cursor.After, cursor.Before
+			resp, err := s.ListWithOptions(ctx, &page)
+			return resp.Data, resp.HasMore, err
+		})
+}
+
 // UpdateWithContext updates an existing Automation
 // https://resend.com/docs/api-reference/automations/update-automation
 func (s *AutomationsSvcImpl) UpdateWithContext(ctx context.Context, automationId string, params *UpdateAutomationRequest) (UpdateAutomationResponse, error) {
@@ -454,6 +526,25 @@ func (s *AutomationsSvcImpl) ListRuns(automationId string) (ListAutomationRunsRe
 	return s.ListRunsWithContext(context.Background(), automationId, nil)
 }
 
+// AllRuns iterates over all runs of an Automation, fetching pages lazily.
+// The Limit, After and Before options are used for the first page.
+// https://resend.com/docs/api-reference/automations/list-automation-runs
+func (s *AutomationsSvcImpl) AllRuns(ctx context.Context, automationId string, options *ListAutomationRunsOptions) iter.Seq2[AutomationRunListItem, error] {
+	var opts ListAutomationRunsOptions
+	if options != nil {
+		opts = *options
+	}
+	cursor := ListOptions{Limit: opts.Limit, After: opts.After, Before: opts.Before}
+
+	return paginate(ctx, cursor, func(r AutomationRunListItem) string { return r.Id },
+		func(ctx context.Context, cursor ListOptions) ([]AutomationRunListItem, bool, error) {
+			page := opts
+			page.Limit, page.After, page.Before = cursor.Limit, cursor.After, cursor.Before
+			resp, err := s.ListRunsWithContext(ctx, automationId, &page)
+			return resp.Data, resp.HasMore, err
+		})
+}
+
 // GetRunWithContext retrieves a single run for an Automation
 // https://resend.com/docs/api-reference/automations/get-automation-run
 func (s *AutomationsSvcImpl) GetRunWithContext(ctx context.Context, automationId string, runId string) (AutomationRun, error) {
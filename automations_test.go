@@ -419,7 +419,7 @@ func TestGetAutomationRun(t *testing.T) {
 	assert.Equal(t, 2, len(resp.Steps))
 	assert.Equal(t, "trigger_1", resp.Steps[0].Key)
 	assert.Equal(t, AutomationStepTypeTrigger, resp.Steps[0].Type)
-	assert.Equal(t, "completed", resp.Steps[0].Status)
+	assert.Equal(t, AutomationRunStepStatusCompleted, resp.Steps[0].Status)
 	assert.Equal(t, "send_1", resp.Steps[1].Key)
 	assert.Equal(t, AutomationStepTypeSendEmail, resp.Steps[1].Type)
 }
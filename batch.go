@@ -2,7 +2,10 @@ package resend
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"net/http"
 )
 
@@ -53,11 +56,44 @@ type BatchError struct {
 	Message string `json:"message"`
 }
 
+// Error returns the position of the rejected email and why it was rejected
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("[ERROR]: Email %d of the batch was rejected: %s", e.Index, e.Message)
+}
+
+// Is makes a BatchError match ErrValidation with errors.Is
+func (e *BatchError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// BatchResult is the outcome of one email of a batch
+type BatchResult struct {
+	// Request is the email at this position of the batch
+	Request *SendEmailRequest
+
+	// Id is the id of the sent email, empty when it was rejected
+	Id string
+
+	// Error is set when the email was rejected in permissive validation mode
+	Error *BatchError
+}
+
+// Failed reports whether the email was rejected
+func (r BatchResult) Failed() bool {
+	return r.Error != nil
+}
+
 // BatchEmailResponse is the response from the BatchSendEmail call.
 // see https://resend.com/docs/api-reference/emails/send-batch-emails
 type BatchEmailResponse struct {
 	Data   []SendEmailResponse `json:"data"`
 	Errors []BatchError        `json:"errors,omitempty"`
+
+	// Results holds the outcome of every email of the batch, at the same
+	// position as in the request. It is filled by the Send methods.
+	Results []BatchResult `json:"-"`
+
+	unknownFields
 }
 
 type BatchSvc interface {
@@ -78,6 +114,12 @@ func (s *BatchSvcImpl) Send(params []*SendEmailRequest) (*BatchEmailResponse, er
 
 // SendWithContext is the same as Send but accepts a ctx as argument
 func (s *BatchSvcImpl) SendWithContext(ctx context.Context, params []*SendEmailRequest) (*BatchEmailResponse, error) {
+	if s.client.ValidateRequests {
+		if err := ValidateBatch(params); err != nil {
+			return nil, err
+		}
+	}
+
 	path := "emails/batch"
 
 	// Prepare request
@@ -95,12 +137,19 @@ func (s *BatchSvcImpl) SendWithContext(ctx context.Context, params []*SendEmailR
 	if err != nil {
 		return nil, err
 	}
+	batchSendEmailResponse.Results = batchResults(params, batchSendEmailResponse)
 
 	return batchSendEmailResponse, nil
 }
 
 // SendWithOptions is the same as Send but accepts a ctx and options as arguments
 func (s *BatchSvcImpl) SendWithOptions(ctx context.Context, params []*SendEmailRequest, options *BatchSendEmailOptions) (*BatchEmailResponse, error) {
+	if s.client.ValidateRequests {
+		if err := ValidateBatch(params); err != nil {
+			return nil, err
+		}
+	}
+
 	// Validate BatchValidation field if provided
 	if options != nil && options.BatchValidation != "" {
 		if !options.BatchValidation.IsValid() {
@@ -125,6 +174,79 @@ func (s *BatchSvcImpl) SendWithOptions(ctx context.Context, params []*SendEmailR
 	if err != nil {
 		return nil, err
 	}
+	batchSendEmailResponse.Results = batchResults(params, batchSendEmailResponse)
 
 	return batchSendEmailResponse, nil
 }
+
+// batchResults aligns the response of a batch with its emails: the
+// responses in Data are in order, skipping the emails rejected in Errors.
+func batchResults(params []*SendEmailRequest, resp *BatchEmailResponse) []BatchResult {
+	results := make([]BatchResult, len(params))
+	for i, param := range params {
+		results[i].Request = param
+	}
+	for i := range resp.Errors {
+		if e := &resp.Errors[i]; e.Index >= 0 && e.Index < len(results) {
+			results[e.Index].Error = e
+		}
+	}
+
+	i := 0
+	for _, data := range resp.Data {
+		for i < len(results) && results[i].Error != nil {
+			i++
+		}
+		if i == len(results) {
+			break
+		}
+		results[i].Id = data.Id
+		i++
+	}
+	return results
+}
+
+// BatchRetry is a batch of the emails rejected from a previous batch, see
+// BatchEmailResponse.Retry
+type BatchRetry struct {
+	// Emails are the rejected emails, to be fixed before sending them again
+	Emails []*SendEmailRequest
+
+	// Indexes holds the position of every email in the previous batch
+	Indexes []int
+
+	// Options are the options of the previous batch with a derived
+	// idempotency key
+	Options *BatchSendEmailOptions
+}
+
+// Retry returns a batch of the rejected emails, to send them again with
+// Batch.SendWithOptions once fixed. It returns nil when no email was rejected.
+//
+// The idempotency key of options is suffixed with a hash of the positions
+// of the rejected emails: retrying the same batch twice reuses the same key,
+// while the key of the previous batch, already used, is not sent again.
+func (r *BatchEmailResponse) Retry(options *BatchSendEmailOptions) *BatchRetry {
+	retry := &BatchRetry{Options: &BatchSendEmailOptions{}}
+	for i, result := range r.Results {
+		if result.Failed() {
+			retry.Emails = append(retry.Emails, result.Request)
+			retry.Indexes = append(retry.Indexes, i)
+		}
+	}
+	if len(retry.Emails) == 0 {
+		return nil
+	}
+
+	if options != nil {
+		*retry.Options = *options
+	}
+	if key := retry.Options.IdempotencyKey; key != "" {
+		hash := sha256.New()
+		for _, i := range retry.Indexes {
+			fmt.Fprintf(hash, "%d,", i)
+		}
+		retry.Options.IdempotencyKey = key + "/retry-" + hex.EncodeToString(hash.Sum(nil)[:8])
+	}
+	return retry
+}
@@ -3,6 +3,7 @@ package resend
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"testing"
 
@@ -340,3 +341,68 @@ func TestBatchSendWithInvalidValidationMode(t *testing.T) {
 	assert.Nil(t, resp)
 	assert.Contains(t, err.Error(), "BatchValidation must be either BatchValidationStrict or BatchValidationPermissive")
 }
+
+func TestBatchSendWithOptionsResults(t *testing.T) {
+	setup()
+	defer teardown()
+	ctx := context.Background()
+
+	var keys []string
+	mux.HandleFunc("/emails/batch", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+
+		var emails []*SendEmailRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&emails))
+		ret := &BatchEmailResponse{Data: []SendEmailResponse{}}
+		for i, email := range emails {
+			if len(email.To) == 0 {
+				ret.Errors = append(ret.Errors, BatchError{Index: i, Message: "The `to` field is missing."})
+				continue
+			}
+			ret.Data = append(ret.Data, SendEmailResponse{Id: email.To[0]})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ret)
+	})
+
+	req := []*SendEmailRequest{
+		{To: []string{"a@example.com"}},
+		{To: []string{}},
+		{To: []string{"c@example.com"}},
+		{},
+	}
+	options := &BatchSendEmailOptions{IdempotencyKey: "batch-1", BatchValidation: BatchValidationPermissive}
+	resp, err := client.Batch.SendWithOptions(ctx, req, options)
+	assert.NoError(t, err)
+
+	assert.Len(t, resp.Results, 4)
+	assert.Equal(t, "a@example.com", resp.Results[0].Id)
+	assert.False(t, resp.Results[0].Failed())
+	assert.Same(t, req[1], resp.Results[1].Request)
+	assert.True(t, resp.Results[1].Failed())
+	assert.Equal(t, 1, resp.Results[1].Error.Index)
+	assert.True(t, errors.Is(resp.Results[1].Error, ErrValidation))
+	assert.Equal(t, "[ERROR]: Email 1 of the batch was rejected: The `to` field is missing.", resp.Results[1].Error.Error())
+	assert.Equal(t, "c@example.com", resp.Results[2].Id)
+	assert.True(t, resp.Results[3].Failed())
+
+	retry := resp.Retry(options)
+	assert.Equal(t, []int{1, 3}, retry.Indexes)
+	assert.Equal(t, []*SendEmailRequest{req[1], req[3]}, retry.Emails)
+	assert.Equal(t, BatchValidationPermissive, retry.Options.BatchValidation)
+	assert.Regexp(t, "^batch-1/retry-[0-9a-f]{16}$", retry.Options.IdempotencyKey)
+	assert.Equal(t, "batch-1", options.IdempotencyKey)
+	assert.Equal(t, retry.Options.IdempotencyKey, resp.Retry(options).Options.IdempotencyKey)
+
+	// Send the fixed emails again
+	retry.Emails[0].To = []string{"b@example.com"}
+	retry.Emails[1].To = []string{"d@example.com"}
+	retried, err := client.Batch.SendWithOptions(ctx, retry.Emails, retry.Options)
+	assert.NoError(t, err)
+	assert.Nil(t, retried.Retry(retry.Options))
+	assert.Equal(t, "d@example.com", retried.Results[1].Id)
+	assert.Equal(t, []string{"batch-1", retry.Options.IdempotencyKey}, keys)
+
+	assert.Empty(t, resp.Retry(nil).Options.IdempotencyKey)
+}
@@ -3,14 +3,42 @@ package resend
 import (
 	"context"
 	"errors"
+	"iter"
 	"net/http"
 )
 
+// BroadcastStatus is the status of a broadcast
+type BroadcastStatus string
+
+const (
+	BroadcastStatusDraft     BroadcastStatus = "draft"
+	BroadcastStatusScheduled BroadcastStatus = "scheduled"
+	BroadcastStatusQueued    BroadcastStatus = "queued"
+	BroadcastStatusSending   BroadcastStatus = "sending"
+	BroadcastStatusSent      BroadcastStatus = "sent"
+	BroadcastStatusCanceled  BroadcastStatus = "canceled"
+)
+
+// IsValid reports whether the BroadcastStatus is one of the known values
+func (s BroadcastStatus) IsValid() bool {
+	switch s {
+	case BroadcastStatusDraft, BroadcastStatusScheduled, BroadcastStatusQueued, BroadcastStatusSending, BroadcastStatusSent, BroadcastStatusCanceled:
+		return true
+	}
+	return false
+}
+
+// String returns the string representation of the BroadcastStatus
+func (s BroadcastStatus) String() string {
+	return string(s)
+}
+
 type SendBroadcastRequest struct {
 	BroadcastId string `json:"broadcast_id"`
 
 	//Schedule email to be sent later. The date should be in language natural (e.g.: in 1 min)
 	// or ISO 8601 format (e.g: 2024-08-05T11:52:01.858Z).
+	// Use ScheduleAt or ScheduleIn to set it from a time.Time or a time.Duration.
 	ScheduledAt string `json:"scheduled_at"`
 }
 
@@ -30,6 +58,7 @@ type CreateBroadcastRequest struct {
 	// Schedule email to be sent later. The date should be in natural language (e.g.: in 1 min)
 	// or ISO 8601 format (e.g: 2024-08-05T11:52:01.858Z).
 	// Only valid when Send is true.
+	// Use ScheduleAt or ScheduleIn to set it from a time.Time or a time.Duration.
 	ScheduledAt string `json:"scheduled_at,omitempty"`
 }
 
@@ -47,49 +76,63 @@ type UpdateBroadcastRequest struct {
 
 type CreateBroadcastResponse struct {
 	Id string `json:"id"`
+
+	unknownFields
 }
 
 type UpdateBroadcastResponse struct {
 	Id string `json:"id"`
+
+	unknownFields
 }
 
 type SendBroadcastResponse struct {
 	Id string `json:"id"`
+
+	unknownFields
 }
 
 type CancelBroadcastResponse struct {
 	Object string `json:"object"`
 	Id     string `json:"id"`
+
+	unknownFields
 }
 
 type RemoveBroadcastResponse struct {
 	Object  string `json:"object"`
 	Id      string `json:"id"`
 	Deleted bool   `json:"deleted"`
+
+	unknownFields
 }
 
 type ListBroadcastsResponse struct {
 	Object  string      `json:"object"`
 	Data    []Broadcast `json:"data"`
 	HasMore bool        `json:"has_more"`
+
+	unknownFields
 }
 
 type Broadcast struct {
-	Object      string   `json:"object"`
-	Id          string   `json:"id"`
-	Name        string   `json:"name"`
-	SegmentId   string   `json:"segment_id"`
-	AudienceId  string   `json:"audience_id"` // Deprecated: Use SegmentId instead
-	From        string   `json:"from"`
-	Subject     string   `json:"subject"`
-	ReplyTo     []string `json:"reply_to"`
-	PreviewText string   `json:"preview_text"`
-	Status      string   `json:"status"`
-	CreatedAt   string   `json:"created_at"`
-	ScheduledAt string   `json:"scheduled_at"`
-	SentAt      string   `json:"sent_at"`
-	Html        string   `json:"html"`
-	Text        string   `json:"text"`
+	Object      string          `json:"object"`
+	Id          string          `json:"id"`
+	Name        string          `json:"name"`
+	SegmentId   string          `json:"segment_id"`
+	AudienceId  string          `json:"audience_id"` // Deprecated: Use SegmentId instead
+	From        string          `json:"from"`
+	Subject     string          `json:"subject"`
+	ReplyTo     []string        `json:"reply_to"`
+	PreviewText string          `json:"preview_text"`
+	Status      BroadcastStatus `json:"status"`
+	CreatedAt   string          `json:"created_at"`
+	ScheduledAt string          `json:"scheduled_at"`
+	SentAt      string          `json:"sent_at"`
+	Html        string          `json:"html"`
+	Text        string          `json:"text"`
+
+	unknownFields
 }
 
 type BroadcastsSvc interface {
@@ -102,6 +145,7 @@ type BroadcastsSvc interface {
 	ListWithOptions(ctx context.Context, options *ListOptions) (ListBroadcastsResponse, error)
 	ListWithContext(ctx context.Context) (ListBroadcastsResponse, error)
 	List() (ListBroadcastsResponse, error)
+	All(ctx context.Context, options *ListOptions) iter.Seq2[Broadcast, error]
 
 	GetWithContext(ctx context.Context, broadcastId string) (Broadcast, error)
 	Get(broadcastId string) (Broadcast, error)
@@ -349,3 +393,13 @@ func (s *BroadcastsSvcImpl) ListWithContext(ctx context.Context) (ListBroadcasts
 func (s *BroadcastsSvcImpl) List() (ListBroadcastsResponse, error) {
 	return s.ListWithContext(context.Background())
 }
+
+// All iterates over all broadcasts, fetching pages lazily
+// https://resend.com/docs/api-reference/broadcasts/list-broadcasts
+func (s *BroadcastsSvcImpl) All(ctx context.Context, options *ListOptions) iter.Seq2[Broadcast, error] {
+	return paginate(ctx, listCursor(options), func(item Broadcast) string { return item.Id },
+		func(ctx context.Context, cursor ListOptions) ([]Broadcast, bool, error) {
+			resp, err := s.ListWithOptions(ctx, &cursor)
+			return resp.Data, resp.HasMore, err
+		})
+}
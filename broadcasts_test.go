@@ -212,7 +212,7 @@ func TestGetBroadcast(t *testing.T) {
 	assert.Equal(t, b.From, "Acme <onboarding@resend.dev>")
 	assert.Equal(t, b.Subject, "hello world")
 	assert.Equal(t, b.PreviewText, "Check out our latest announcements")
-	assert.Equal(t, b.Status, "draft")
+	assert.Equal(t, b.Status, BroadcastStatusDraft)
 	assert.Equal(t, b.CreatedAt, "2024-12-01 19:32:22.98+00")
 }
 
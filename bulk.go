@@ -0,0 +1,353 @@
+package resend
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+	"sync"
+)
+
+// DefaultBulkConcurrency is the number of batches a BulkSender sends at
+// once when BulkOptions.Concurrency is not set. It matches the default rate
+// limit of the API, 2 requests per second.
+const DefaultBulkConcurrency = 2
+
+// BulkOptions configures a BulkSender
+type BulkOptions struct {
+	// IdempotencyKey is the prefix of the idempotency key of every batch,
+	// followed by the batch number, ie: "newsletter-42/0". When empty, the
+	// key of a batch is derived from its content.
+	IdempotencyKey string
+
+	// ChunkSize is the number of emails per batch, MaxBatchSize by default
+	ChunkSize int
+
+	// Concurrency is the maximum number of batches in flight,
+	// DefaultBulkConcurrency by default. Requests are still paced by the
+	// RateLimiter and retried according to the RetryPolicy of the client.
+	Concurrency int
+
+	// BatchValidation is the validation mode of every batch,
+	// see BatchSendEmailOptions
+	BatchValidation BatchValidationMode
+
+	// Offset skips the first emails, to resume a previous run from the
+	// Checkpoint of its last BulkProgress. It must be a multiple of ChunkSize.
+	Offset int
+
+	// OnProgress is called with the latest progress after batches are sent.
+	// Calls never overlap, and batches finishing during a call are reported
+	// together by the next one. The run returns after the last call.
+	OnProgress func(BulkProgress)
+}
+
+// BulkProgress reports the progress of a BulkSender run
+type BulkProgress struct {
+	// Sent is the number of emails accepted by the API
+	Sent int
+
+	// Rejected is the number of emails rejected in permissive validation mode
+	Rejected int
+
+	// Chunks is the number of batches sent
+	Chunks int
+
+	// Checkpoint is the number of leading emails whose batches were all
+	// sent. Running again with it as BulkOptions.Offset resumes the job,
+	// reusing the idempotency keys of the batches that may have been sent.
+	Checkpoint int
+}
+
+// BulkResult is the result of a BulkSender run
+type BulkResult struct {
+	// Data holds the response of every email at its position in the input.
+	// It is zero for the emails that were skipped, rejected or not sent.
+	Data []SendEmailResponse
+
+	// Errors holds the emails rejected in permissive validation mode, with
+	// Index being their position in the input
+	Errors []BatchError
+
+	// Progress is the final progress of the run
+	Progress BulkProgress
+}
+
+// BulkChunkError is returned by BulkSender when a batch could not be sent
+type BulkChunkError struct {
+	// Chunk is the number of the batch
+	Chunk int
+
+	// Start and End are the positions of the first email of the batch and
+	// of the one after its last
+	Start int
+	End   int
+
+	// Err is the error returned by Batch.SendWithOptions
+	Err error
+}
+
+// Error returns the batch that failed and why
+func (e *BulkChunkError) Error() string {
+	return fmt.Sprintf("[ERROR]: Failed to send emails %d to %d: %v", e.Start, e.End-1, e.Err)
+}
+
+// Unwrap returns the error returned by Batch.SendWithOptions
+func (e *BulkChunkError) Unwrap() error {
+	return e.Err
+}
+
+// BulkSender sends any number of emails through Batch.SendWithOptions,
+// splitting them in batches of at most MaxBatchSize emails.
+//
+//	sender := resend.NewBulkSender(client.Batch, &resend.BulkOptions{
+//		IdempotencyKey: "newsletter-42",
+//		OnProgress:     func(p resend.BulkProgress) { saveCheckpoint(p.Checkpoint) },
+//	})
+//	result, err := sender.Send(ctx, params)
+//
+// Batches are sent concurrently. Once one fails no new batch is started,
+// and the run returns the *BulkChunkError of the failed batches. Like
+// batches, bulk sends do not support attachments.
+type BulkSender struct {
+	batch   BatchSvc
+	options BulkOptions
+}
+
+// NewBulkSender returns a BulkSender sending with the given service,
+// usually client.Batch. A nil options uses the defaults.
+func NewBulkSender(batch BatchSvc, options *BulkOptions) *BulkSender {
+	s := &BulkSender{batch: batch}
+	if options != nil {
+		s.options = *options
+	}
+	if s.options.ChunkSize <= 0 || s.options.ChunkSize > MaxBatchSize {
+		s.options.ChunkSize = MaxBatchSize
+	}
+	if s.options.Concurrency <= 0 {
+		s.options.Concurrency = DefaultBulkConcurrency
+	}
+	return s
+}
+
+// Send sends every email of params
+func (s *BulkSender) Send(ctx context.Context, params []*SendEmailRequest) (*BulkResult, error) {
+	return s.SendSeq(ctx, slices.Values(params))
+}
+
+// SendSeq sends every email of the iterator, reading it as batches are
+// sent. The iterator is not read any further once a batch fails or the
+// context is done.
+func (s *BulkSender) SendSeq(ctx context.Context, params iter.Seq[*SendEmailRequest]) (*BulkResult, error) {
+	if s.options.Offset < 0 || s.options.Offset%s.options.ChunkSize != 0 {
+		return nil, errors.New("[ERROR]: Offset must be a multiple of ChunkSize")
+	}
+	if s.options.BatchValidation != "" && !s.options.BatchValidation.IsValid() {
+		return nil, errors.New("[ERROR]: BatchValidation must be either BatchValidationStrict or BatchValidationPermissive")
+	}
+
+	run := &bulkRun{
+		sender: s,
+		done:   make(map[int]int),
+		result: &BulkResult{Progress: BulkProgress{Checkpoint: s.options.Offset}},
+	}
+	sem := make(chan struct{}, s.options.Concurrency)
+	var wg sync.WaitGroup
+
+	dispatch := func(chunk int, emails []*SendEmailRequest) bool {
+		if ctx.Err() != nil {
+			return false
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			return false
+		}
+		if run.stopped() {
+			<-sem
+			return false
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			run.send(ctx, chunk, emails)
+		}()
+		return true
+	}
+
+	total, ok := 0, true
+	var pending []*SendEmailRequest
+	for email := range params {
+		total++
+		if total <= s.options.Offset {
+			continue
+		}
+		pending = append(pending, email)
+		if len(pending) == s.options.ChunkSize {
+			if ok = dispatch((total-1)/s.options.ChunkSize, pending); !ok {
+				break
+			}
+			pending = nil
+		}
+	}
+	if ok && len(pending) > 0 {
+		dispatch((total-1)/s.options.ChunkSize, pending)
+	}
+	wg.Wait()
+
+	if len(run.result.Data) < total {
+		run.result.Data = append(run.result.Data, make([]SendEmailResponse, total-len(run.result.Data))...)
+	}
+	slices.SortFunc(run.result.Errors, func(a, b BatchError) int { return a.Index - b.Index })
+	slices.SortFunc(run.errs, func(a, b *BulkChunkError) int { return a.Chunk - b.Chunk })
+
+	if len(run.errs) == 0 {
+		return run.result, ctx.Err()
+	}
+	errs := make([]error, len(run.errs))
+	for i, err := range run.errs {
+		errs[i] = err
+	}
+	return run.result, errors.Join(errs...)
+}
+
+// bulkRun holds the state shared by the batches of a BulkSender run
+type bulkRun struct {
+	sender *BulkSender
+
+	mu sync.Mutex
+
+	// reporting is held by the batch calling OnProgress, which is called
+	// without holding mu
+	reporting sync.Mutex
+
+	// reported is the number of batches of the last progress reported
+	reported int
+
+	// done holds the end position of the sent batches past the checkpoint
+	done   map[int]int
+	result *BulkResult
+	errs   []*BulkChunkError
+}
+
+// stopped reports whether a batch failed
+func (r *bulkRun) stopped() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.errs) > 0
+}
+
+// send sends a batch and records its result
+func (r *bulkRun) send(ctx context.Context, chunk int, emails []*SendEmailRequest) {
+	opts := r.sender.options
+
+	key, err := chunkIdempotencyKey(opts.IdempotencyKey, chunk, emails)
+	var resp *BatchEmailResponse
+	if err == nil {
+		resp, err = r.sender.batch.SendWithOptions(ctx, emails, &BatchSendEmailOptions{
+			IdempotencyKey:  key,
+			BatchValidation: opts.BatchValidation,
+		})
+	}
+
+	if r.record(chunk, emails, resp, err) && opts.OnProgress != nil {
+		r.report()
+	}
+}
+
+// report calls OnProgress until the latest progress is reported. The
+// batches finishing meanwhile leave their progress to the batch already
+// reporting, instead of waiting for a slow callback.
+func (r *bulkRun) report() {
+	for r.reporting.TryLock() {
+		for {
+			r.mu.Lock()
+			progress := r.result.Progress
+			r.reported = progress.Chunks
+			r.mu.Unlock()
+
+			r.sender.options.OnProgress(progress)
+
+			r.mu.Lock()
+			done := r.reported == r.result.Progress.Chunks
+			r.mu.Unlock()
+			if done {
+				break
+			}
+		}
+		r.reporting.Unlock()
+
+		// A batch may have finished between the last check and the
+		// unlock, failing to report its progress
+		r.mu.Lock()
+		done := r.reported == r.result.Progress.Chunks
+		r.mu.Unlock()
+		if done {
+			return
+		}
+	}
+}
+
+// record stores the result of a batch, and reports whether it was sent
+func (r *bulkRun) record(chunk int, emails []*SendEmailRequest, resp *BatchEmailResponse, err error) bool {
+	opts := r.sender.options
+	start := chunk * opts.ChunkSize
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err != nil {
+		r.errs = append(r.errs, &BulkChunkError{Chunk: chunk, Start: start, End: start + len(emails), Err: err})
+		return false
+	}
+
+	if end := start + len(emails); len(r.result.Data) < end {
+		r.result.Data = append(r.result.Data, make([]SendEmailResponse, end-len(r.result.Data))...)
+	}
+	results := resp.Results
+	if len(results) != len(emails) {
+		results = batchResults(emails, resp)
+	}
+	for i, result := range results {
+		r.result.Data[start+i] = SendEmailResponse{Id: result.Id}
+	}
+	for _, e := range resp.Errors {
+		r.result.Errors = append(r.result.Errors, BatchError{Index: start + e.Index, Message: e.Message})
+	}
+
+	progress := &r.result.Progress
+	progress.Sent += len(resp.Data)
+	progress.Rejected += len(resp.Errors)
+	progress.Chunks++
+	r.done[chunk] = start + len(emails)
+	for {
+		next := progress.Checkpoint / opts.ChunkSize
+		end, ok := r.done[next]
+		if !ok {
+			break
+		}
+		delete(r.done, next)
+		progress.Checkpoint = end
+	}
+	return true
+}
+
+// chunkIdempotencyKey returns the idempotency key of a batch: the prefix
+// followed by the batch number, or a hash of the batch without prefix.
+func chunkIdempotencyKey(prefix string, chunk int, emails []*SendEmailRequest) (string, error) {
+	if prefix != "" {
+		return prefix + "/" + strconv.Itoa(chunk), nil
+	}
+	data, err := json.Marshal(emails)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return "bulk/" + hex.EncodeToString(sum[:16]), nil
+}
@@ -0,0 +1,211 @@
+package resend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// bulkEmails returns n emails whose subject is their position
+func bulkEmails(n int) []*SendEmailRequest {
+	emails := make([]*SendEmailRequest, n)
+	for i := range emails {
+		emails[i] = &SendEmailRequest{From: "a@example.com", To: []string{"b@example.com"}, Subject: fmt.Sprint(i)}
+	}
+	return emails
+}
+
+// bulkHandler serves the batch endpoint, rejecting the emails for which
+// reject returns true in permissive mode and failing the batches for which
+// fail returns true. The id of an email is its subject.
+func bulkHandler(t *testing.T, keys *[]string, reject func(*SendEmailRequest) bool, fail func(key string) bool) http.HandlerFunc {
+	var mu sync.Mutex
+	return func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		key := r.Header.Get("Idempotency-Key")
+		mu.Lock()
+		*keys = append(*keys, key)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if fail != nil && fail(key) {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"name":"internal_server_error","message":"boom"}`))
+			return
+		}
+
+		var emails []*SendEmailRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&emails))
+		resp := &BatchEmailResponse{Data: []SendEmailResponse{}}
+		for i, email := range emails {
+			if reject != nil && reject(email) && r.Header.Get("x-batch-validation") == "permissive" {
+				resp.Errors = append(resp.Errors, BatchError{Index: i, Message: "rejected"})
+				continue
+			}
+			resp.Data = append(resp.Data, SendEmailResponse{Id: email.Subject})
+		}
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+func TestBulkSenderChunks(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var keys []string
+	var inFlight, maxInFlight atomic.Int32
+	handler := bulkHandler(t, &keys, nil, nil)
+	mux.HandleFunc("/emails/batch", func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for m := maxInFlight.Load(); n > m && !maxInFlight.CompareAndSwap(m, n); m = maxInFlight.Load() {
+		}
+		handler(w, r)
+	})
+
+	var progress []BulkProgress
+	sender := NewBulkSender(client.Batch, &BulkOptions{
+		IdempotencyKey: "job-1",
+		OnProgress:     func(p BulkProgress) { progress = append(progress, p) },
+	})
+	result, err := sender.Send(context.Background(), bulkEmails(250))
+	assert.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"job-1/0", "job-1/1", "job-1/2"}, keys)
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(DefaultBulkConcurrency))
+	assert.Len(t, result.Data, 250)
+	for i, data := range result.Data {
+		assert.Equal(t, fmt.Sprint(i), data.Id)
+	}
+	assert.NotEmpty(t, progress)
+	for i := 1; i < len(progress); i++ {
+		assert.Greater(t, progress[i].Chunks, progress[i-1].Chunks)
+	}
+	assert.Equal(t, BulkProgress{Sent: 250, Chunks: 3, Checkpoint: 250}, result.Progress)
+	assert.Equal(t, result.Progress, progress[len(progress)-1])
+}
+
+func TestBulkSenderSlowProgress(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var keys []string
+	var requests atomic.Int32
+	handler := bulkHandler(t, &keys, nil, nil)
+	mux.HandleFunc("/emails/batch", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		handler(w, r)
+	})
+
+	// The first call blocks until every batch is sent, which requires the
+	// other batches not to wait for it
+	var progress []BulkProgress
+	sender := NewBulkSender(client.Batch, &BulkOptions{
+		ChunkSize: 1,
+		OnProgress: func(p BulkProgress) {
+			if len(progress) == 0 {
+				deadline := time.Now().Add(5 * time.Second)
+				for requests.Load() < 4 && time.Now().Before(deadline) {
+					time.Sleep(time.Millisecond)
+				}
+				assert.Equal(t, int32(4), requests.Load())
+			}
+			progress = append(progress, p)
+		},
+	})
+	result, err := sender.Send(context.Background(), bulkEmails(4))
+	assert.NoError(t, err)
+	assert.Equal(t, result.Progress, progress[len(progress)-1])
+	assert.Equal(t, 4, result.Progress.Checkpoint)
+}
+
+func TestChunkIdempotencyKeyNilEmail(t *testing.T) {
+	key, err := chunkIdempotencyKey("", 0, []*SendEmailRequest{nil, bulkEmails(1)[0]})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, key)
+}
+
+func TestBulkSenderPermissive(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var keys []string
+	reject := func(e *SendEmailRequest) bool { return e.Subject == "1" || e.Subject == "4" }
+	mux.HandleFunc("/emails/batch", bulkHandler(t, &keys, reject, nil))
+
+	sender := NewBulkSender(client.Batch, &BulkOptions{ChunkSize: 2, Concurrency: 1, BatchValidation: BatchValidationPermissive})
+	result, err := sender.SendSeq(context.Background(), func(yield func(*SendEmailRequest) bool) {
+		for _, email := range bulkEmails(5) {
+			if !yield(email) {
+				return
+			}
+		}
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"0", "", "2", "3", ""}, responseIds(result.Data))
+	assert.Equal(t, []BatchError{{Index: 1, Message: "rejected"}, {Index: 4, Message: "rejected"}}, result.Errors)
+	assert.Equal(t, BulkProgress{Sent: 3, Rejected: 2, Chunks: 3, Checkpoint: 5}, result.Progress)
+
+	// Keys are derived from the content of the batches
+	assert.Len(t, keys, 3)
+	assert.Regexp(t, "^bulk/[0-9a-f]{32}$", keys[0])
+	assert.NotEqual(t, keys[0], keys[1])
+	again, err := chunkIdempotencyKey("", 0, bulkEmails(2))
+	assert.NoError(t, err)
+	assert.Equal(t, keys[0], again)
+}
+
+func TestBulkSenderResume(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var keys []string
+	failing := true
+	mux.HandleFunc("/emails/batch", bulkHandler(t, &keys, nil, func(key string) bool {
+		return failing && key == "job-2/1"
+	}))
+
+	emails := bulkEmails(6)
+	options := &BulkOptions{IdempotencyKey: "job-2", ChunkSize: 2, Concurrency: 1}
+	result, err := NewBulkSender(client.Batch, options).Send(context.Background(), emails)
+
+	var chunkErr *BulkChunkError
+	assert.True(t, errors.As(err, &chunkErr))
+	assert.Equal(t, 1, chunkErr.Chunk)
+	assert.Equal(t, 2, chunkErr.Start)
+	assert.Equal(t, 4, chunkErr.End)
+	assert.True(t, errors.Is(err, ErrServer))
+	assert.Equal(t, []string{"job-2/0", "job-2/1"}, keys)
+	assert.Equal(t, BulkProgress{Sent: 2, Chunks: 1, Checkpoint: 2}, result.Progress)
+	assert.Equal(t, []string{"0", "1", "", "", "", ""}, responseIds(result.Data))
+
+	failing, keys = false, nil
+	options.Offset = result.Progress.Checkpoint
+	result, err = NewBulkSender(client.Batch, options).Send(context.Background(), emails)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"job-2/1", "job-2/2"}, keys)
+	assert.Equal(t, []string{"", "", "2", "3", "4", "5"}, responseIds(result.Data))
+	assert.Equal(t, 6, result.Progress.Checkpoint)
+
+	options.Offset = 3
+	_, err = NewBulkSender(client.Batch, options).Send(context.Background(), emails)
+	assert.Error(t, err)
+}
+
+// responseIds returns the ids of the responses
+func responseIds(data []SendEmailResponse) []string {
+	ids := make([]string, len(data))
+	for i, d := range data {
+		ids[i] = d.Id
+	}
+	return ids
+}
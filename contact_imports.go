@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"iter"
 	"mime/multipart"
 	"net/http"
 	"net/url"
@@ -35,6 +36,8 @@ type ContactImport struct {
 	Status    ContactImportStatus  `json:"status"`
 	CreatedAt string               `json:"created_at"`
 	Counts    *ContactImportCounts `json:"counts,omitempty"`
+
+	unknownFields
 }
 
 // ContactImportSegment represents a segment reference for a contact import.
@@ -66,6 +69,8 @@ type CreateContactImportRequest struct {
 type CreateContactImportResponse struct {
 	Object string `json:"object"`
 	Id     string `json:"id"`
+
+	unknownFields
 }
 
 type ListContactImportsOptions struct {
@@ -80,6 +85,8 @@ type ListContactImportsResponse struct {
 	Object  string          `json:"object"`
 	HasMore bool            `json:"has_more"`
 	Data    []ContactImport `json:"data"`
+
+	unknownFields
 }
 
 type ContactImportsSvc interface {
@@ -89,6 +96,7 @@ type ContactImportsSvc interface {
 	GetWithContext(ctx context.Context, id string) (ContactImport, error)
 	List(options *ListContactImportsOptions) (ListContactImportsResponse, error)
 	ListWithContext(ctx context.Context, options *ListContactImportsOptions) (ListContactImportsResponse, error)
+	All(ctx context.Context, options *ListContactImportsOptions) iter.Seq2[ContactImport, error]
 }
 
 type ContactImportsSvcImpl struct {
@@ -223,8 +231,30 @@ func (s *ContactImportsSvcImpl) ListWithContext(ctx context.Context, options *Li
 	return *resp, nil
 }
 
+// All iterates over all contact imports, fetching pages lazily.
+// The Limit, After and Before options are used for the first page.
+// https://resend.com/docs/api-reference/contacts/list-contact-imports
+func (s *ContactImportsSvcImpl) All(ctx context.Context, options *ListContactImportsOptions) iter.Seq2[ContactImport, error] {
+	var opts ListContactImportsOptions
+	if options != nil {
+		opts = *options
+	}
+	cursor := ListOptions{Limit: opts.Limit, After: opts.After, Before: opts.Before}
+
+	return paginate(ctx, cursor, func(i ContactImport) string { return i.Id },
+		func(ctx context.Context, cursor ListOptions) ([]ContactImport, bool, error) {
+			opts.Limit, opts.After, opts.Before = cursor.Limit, cursor.After, cursor.Before
+			resp, err := s.ListWithContext(ctx, &opts)
+			return resp.Data, resp.HasMore, err
+		})
+}
+
 // NewMultipartRequest builds an HTTP multipart/form-data request for file uploads.
 func (c *Client) NewMultipartRequest(ctx context.Context, path string, fileBytes []byte, filename string, fields map[string]string) (*http.Request, error) {
+	if c.optionErr != nil {
+		return nil, c.optionErr
+	}
+
 	var buf bytes.Buffer
 	w := multipart.NewWriter(&buf)
 
@@ -3,6 +3,7 @@ package resend
 import (
 	"context"
 	"errors"
+	"iter"
 	"net/http"
 )
 
@@ -12,6 +13,7 @@ type ContactPropertiesSvc interface {
 	ListWithOptions(ctx context.Context, options *ListOptions) (ListContactPropertiesResponse, error)
 	ListWithContext(ctx context.Context) (ListContactPropertiesResponse, error)
 	List() (ListContactPropertiesResponse, error)
+	All(ctx context.Context, options *ListOptions) iter.Seq2[ContactProperty, error]
 	GetWithContext(ctx context.Context, id string) (ContactProperty, error)
 	Get(id string) (ContactProperty, error)
 	UpdateWithContext(ctx context.Context, params *UpdateContactPropertyRequest) (UpdateContactPropertyResponse, error)
@@ -31,6 +33,8 @@ type ContactProperty struct {
 	CreatedAt     string `json:"created_at"`
 	Type          string `json:"type"`
 	FallbackValue any    `json:"fallback_value"`
+
+	unknownFields
 }
 
 type CreateContactPropertyRequest struct {
@@ -42,6 +46,8 @@ type CreateContactPropertyRequest struct {
 type CreateContactPropertyResponse struct {
 	Id     string `json:"id"`
 	Object string `json:"object"`
+
+	unknownFields
 }
 
 type UpdateContactPropertyRequest struct {
@@ -52,18 +58,24 @@ type UpdateContactPropertyRequest struct {
 type UpdateContactPropertyResponse struct {
 	Id     string `json:"id"`
 	Object string `json:"object"`
+
+	unknownFields
 }
 
 type RemoveContactPropertyResponse struct {
 	Id      string `json:"id"`
 	Object  string `json:"object"`
 	Deleted bool   `json:"deleted"`
+
+	unknownFields
 }
 
 type ListContactPropertiesResponse struct {
 	Object  string            `json:"object"`
 	Data    []ContactProperty `json:"data"`
 	HasMore bool              `json:"has_more"`
+
+	unknownFields
 }
 
 // CreateWithContext creates a new contact property based on the given params
@@ -139,6 +151,16 @@ func (s *ContactPropertiesSvcImpl) List() (ListContactPropertiesResponse, error)
 	return s.ListWithContext(context.Background())
 }
 
+// All iterates over all contact properties, fetching pages lazily
+// https://resend.com/docs/api-reference/contact-properties/list-contact-properties
+func (s *ContactPropertiesSvcImpl) All(ctx context.Context, options *ListOptions) iter.Seq2[ContactProperty, error] {
+	return paginate(ctx, listCursor(options), func(item ContactProperty) string { return item.Id },
+		func(ctx context.Context, cursor ListOptions) ([]ContactProperty, bool, error) {
+			resp, err := s.ListWithOptions(ctx, &cursor)
+			return resp.Data, resp.HasMore, err
+		})
+}
+
 // GetWithContext retrieves a single contact property by ID
 // https://resend.com/docs/api-reference/contact-properties/get-contact-property
 func (s *ContactPropertiesSvcImpl) GetWithContext(ctx context.Context, id string) (ContactProperty, error) {
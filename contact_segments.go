@@ -3,6 +3,7 @@ package resend
 import (
 	"context"
 	"errors"
+	"iter"
 	"net/http"
 )
 
@@ -14,6 +15,7 @@ type ContactSegmentsSvc interface {
 	ListWithOptions(ctx context.Context, params *ListContactSegmentsRequest, options *ListOptions) (ListContactSegmentsResponse, error)
 	ListWithContext(ctx context.Context, params *ListContactSegmentsRequest) (ListContactSegmentsResponse, error)
 	List(params *ListContactSegmentsRequest) (ListContactSegmentsResponse, error)
+	All(ctx context.Context, params *ListContactSegmentsRequest, options *ListOptions) iter.Seq2[Segment, error]
 }
 
 type ContactSegmentsSvcImpl struct {
@@ -29,6 +31,8 @@ type AddContactSegmentRequest struct {
 type AddContactSegmentResponse struct {
 	Id     string `json:"id"`
 	Object string `json:"object"`
+
+	unknownFields
 }
 
 type RemoveContactSegmentRequest struct {
@@ -41,6 +45,8 @@ type RemoveContactSegmentResponse struct {
 	Id      string `json:"id"`
 	Object  string `json:"object"`
 	Deleted bool   `json:"deleted"`
+
+	unknownFields
 }
 
 type ListContactSegmentsRequest struct {
@@ -52,6 +58,8 @@ type ListContactSegmentsResponse struct {
 	Object  string    `json:"object"`
 	Data    []Segment `json:"data"`
 	HasMore bool      `json:"has_more"`
+
+	unknownFields
 }
 
 // AddWithContext adds a contact to a segment
@@ -185,3 +193,13 @@ func (s *ContactSegmentsSvcImpl) ListWithContext(ctx context.Context, params *Li
 func (s *ContactSegmentsSvcImpl) List(params *ListContactSegmentsRequest) (ListContactSegmentsResponse, error) {
 	return s.ListWithContext(context.Background(), params)
 }
+
+// All iterates over all segments of a contact, fetching pages lazily
+// https://resend.com/docs/api-reference/contacts/list-contact-segments
+func (s *ContactSegmentsSvcImpl) All(ctx context.Context, params *ListContactSegmentsRequest, options *ListOptions) iter.Seq2[Segment, error] {
+	return paginate(ctx, listCursor(options), func(seg Segment) string { return seg.Id },
+		func(ctx context.Context, cursor ListOptions) ([]Segment, bool, error) {
+			resp, err := s.ListWithOptions(ctx, params, &cursor)
+			return resp.Data, resp.HasMore, err
+		})
+}
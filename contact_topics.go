@@ -3,15 +3,42 @@ package resend
 import (
 	"context"
 	"errors"
+	"iter"
 	"net/http"
 )
 
+// TopicSubscription is the subscription of a contact to a topic
+type TopicSubscription string
+
+const (
+	// TopicSubscriptionOptIn the contact receives the emails of the topic
+	TopicSubscriptionOptIn TopicSubscription = "opt_in"
+	// TopicSubscriptionOptOut the contact does not receive the emails of the topic
+	TopicSubscriptionOptOut TopicSubscription = "opt_out"
+)
+
+// IsValid reports whether the TopicSubscription is one of the known values
+func (s TopicSubscription) IsValid() bool {
+	switch s {
+	case TopicSubscriptionOptIn, TopicSubscriptionOptOut:
+		return true
+	}
+	return false
+}
+
+// String returns the string representation of the TopicSubscription
+func (s TopicSubscription) String() string {
+	return string(s)
+}
+
 // ContactTopic represents a topic subscription for a contact
 type ContactTopic struct {
-	Id           string `json:"id"`
-	Name         string `json:"name"`
-	Description  string `json:"description"`
-	Subscription string `json:"subscription"`
+	Id           string            `json:"id"`
+	Name         string            `json:"name"`
+	Description  string            `json:"description"`
+	Subscription TopicSubscription `json:"subscription"`
+
+	unknownFields
 }
 
 // ListContactTopicsResponse is the response from listing contact topics
@@ -19,12 +46,14 @@ type ListContactTopicsResponse struct {
 	Object  string         `json:"object"`
 	HasMore bool           `json:"has_more"`
 	Data    []ContactTopic `json:"data"`
+
+	unknownFields
 }
 
 // TopicSubscriptionUpdate represents a single topic subscription update
 type TopicSubscriptionUpdate struct {
-	Id           string `json:"id"`
-	Subscription string `json:"subscription"`
+	Id           string            `json:"id"`
+	Subscription TopicSubscription `json:"subscription"`
 }
 
 // UpdateContactTopicsRequest is the request for updating contact topics
@@ -37,6 +66,8 @@ type UpdateContactTopicsRequest struct {
 // UpdateContactTopicsResponse is the response from updating contact topics
 type UpdateContactTopicsResponse struct {
 	Id string `json:"id"`
+
+	unknownFields
 }
 
 // ContactTopicsSvc handles operations for contact topics
@@ -44,6 +75,7 @@ type ContactTopicsSvc interface {
 	ListWithOptions(ctx context.Context, id string, options *ListOptions) (ListContactTopicsResponse, error)
 	ListWithContext(ctx context.Context, id string) (ListContactTopicsResponse, error)
 	List(id string) (ListContactTopicsResponse, error)
+	All(ctx context.Context, id string, options *ListOptions) iter.Seq2[ContactTopic, error]
 	UpdateWithContext(ctx context.Context, params *UpdateContactTopicsRequest) (UpdateContactTopicsResponse, error)
 	Update(params *UpdateContactTopicsRequest) (UpdateContactTopicsResponse, error)
 }
@@ -95,6 +127,16 @@ func (s *ContactTopicsSvcImpl) List(id string) (ListContactTopicsResponse, error
 	return s.ListWithContext(context.Background(), id)
 }
 
+// All iterates over all topic subscriptions of a contact, fetching pages lazily
+// https://resend.com/docs/api-reference/contacts/get-contact-topics
+func (s *ContactTopicsSvcImpl) All(ctx context.Context, id string, options *ListOptions) iter.Seq2[ContactTopic, error] {
+	return paginate(ctx, listCursor(options), func(t ContactTopic) string { return t.Id },
+		func(ctx context.Context, cursor ListOptions) ([]ContactTopic, bool, error) {
+			resp, err := s.ListWithOptions(ctx, id, &cursor)
+			return resp.Data, resp.HasMore, err
+		})
+}
+
 // UpdateWithContext updates topic subscriptions for a contact.
 // Either Id or Email must be provided in the params.
 // https://resend.com/docs/api-reference/contacts/update-contact-topics
@@ -55,12 +55,12 @@ func TestListContactTopics(t *testing.T) {
 	assert.Equal(t, "b6d24b8e-af0b-4c3c-be0c-359bbd97381e", topics.Data[0].Id)
 	assert.Equal(t, "Product Updates", topics.Data[0].Name)
 	assert.Equal(t, "New features, and latest announcements.", topics.Data[0].Description)
-	assert.Equal(t, "opt_in", topics.Data[0].Subscription)
+	assert.Equal(t, TopicSubscriptionOptIn, topics.Data[0].Subscription)
 
 	// Check second topic
 	assert.Equal(t, "07d84122-7224-4881-9c31-1c048e204602", topics.Data[1].Id)
 	assert.Equal(t, "Newsletter", topics.Data[1].Name)
-	assert.Equal(t, "opt_out", topics.Data[1].Subscription)
+	assert.Equal(t, TopicSubscriptionOptOut, topics.Data[1].Subscription)
 }
 
 func TestListContactTopicsByEmail(t *testing.T) {
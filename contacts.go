@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"iter"
 	"net/http"
 )
 
@@ -14,6 +15,7 @@ type ContactsSvc interface {
 	GetWithContext(ctx context.Context, options *GetContactOptions) (Contact, error)
 	List(options *ListContactsOptions) (ListContactsResponse, error)
 	ListWithContext(ctx context.Context, options *ListContactsOptions) (ListContactsResponse, error)
+	All(ctx context.Context, options *ListContactsOptions) iter.Seq2[Contact, error]
 	Update(params *UpdateContactRequest) (UpdateContactResponse, error)
 	UpdateWithContext(ctx context.Context, params *UpdateContactRequest) (UpdateContactResponse, error)
 	Remove(options *RemoveContactOptions) (RemoveContactResponse, error)
@@ -101,23 +103,31 @@ func (r *UpdateContactRequest) SetUnsubscribed(val bool) {
 type UpdateContactResponse struct {
 	Data  Contact  `json:"data"`
 	Error struct{} `json:"error"` // Fix this
+
+	unknownFields
 }
 
 type CreateContactResponse struct {
 	Object string `json:"object"`
 	Id     string `json:"id"`
+
+	unknownFields
 }
 
 type RemoveContactResponse struct {
 	Id      string `json:"id"`
 	Object  string `json:"object"`
 	Deleted bool   `json:"deleted"`
+
+	unknownFields
 }
 
 type ListContactsResponse struct {
 	Object  string    `json:"object"`
 	Data    []Contact `json:"data"`
 	HasMore bool      `json:"has_more"`
+
+	unknownFields
 }
 
 type Contact struct {
@@ -129,6 +139,8 @@ type Contact struct {
 	CreatedAt    string         `json:"created_at"`
 	Unsubscribed bool           `json:"unsubscribed"`
 	Properties   map[string]any `json:"properties,omitempty"` // Custom properties for global contacts (currently API only returns string values)
+
+	unknownFields
 }
 
 // Create creates a new Contact based on the given params
@@ -235,6 +247,25 @@ func (s *ContactsSvcImpl) ListWithContext(ctx context.Context, options *ListCont
 	return *contacts, nil
 }
 
+// All iterates over all contacts, fetching pages lazily.
+// The Limit, After and Before options are used for the first page.
+// https://resend.com/docs/api-reference/contacts/list-contacts
+func (s *ContactsSvcImpl) All(ctx context.Context, options *ListContactsOptions) iter.Seq2[Contact, error] {
+	var opts ListContactsOptions
+	if options != nil {
+		opts = *options
+	}
+	cursor := ListOptions{Limit: opts.Limit, After: opts.After, Before: opts.Before}
+
+	return paginate(ctx, cursor, func(c Contact) string { return c.Id },
+		func(ctx context.Context, cursor ListOptions) ([]Contact, bool, error) {
+			page := opts
+			page.Limit, page.After, page.Before = cursor.Limit, cursor.After, cursor.Before
+			resp, err := s.ListWithContext(ctx, &page)
+			return resp.Data, resp.HasMore, err
+		})
+}
+
 // Remove removes a contact
 // If options.AudienceId is empty, removes a global contact. Otherwise removes an audience-specific contact.
 // The options.Id field can be either a contact ID or email address.
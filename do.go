@@ -0,0 +1,109 @@
+package resend
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// RequestOption customizes a request made with Do or List
+type RequestOption func(*http.Request)
+
+// WithIdempotencyKey sets the Idempotency-Key header of a POST request
+func WithIdempotencyKey(key string) RequestOption {
+	return func(req *http.Request) {
+		if key != "" && req.Method == http.MethodPost {
+			req.Header.Set("Idempotency-Key", key)
+		}
+	}
+}
+
+// WithQuery adds the given values to the query string of the request
+func WithQuery(values url.Values) RequestOption {
+	return func(req *http.Request) {
+		query := req.URL.Query()
+		for key, vs := range values {
+			for _, v := range vs {
+				query.Add(key, v)
+			}
+		}
+		req.URL.RawQuery = query.Encode()
+	}
+}
+
+// WithRequestHeader sets a header on a single request, overriding the
+// client's headers with the same key.
+func WithRequestHeader(key, value string) RequestOption {
+	return func(req *http.Request) {
+		req.Header.Set(key, value)
+	}
+}
+
+// ListResponse is the envelope of every cursor-paginated list endpoint
+type ListResponse[T any] struct {
+	Object  string `json:"object"`
+	HasMore bool   `json:"has_more"`
+	Data    []T    `json:"data"`
+
+	unknownFields
+}
+
+// Do calls an endpoint of the Resend API that is not wrapped by a service
+// yet, and decodes its response into a T.
+//
+// The request goes through the client like any other: it carries the API
+// key and client headers, runs the middleware chain, is retried according
+// to the RetryPolicy and fails with the same error types. A nil body sends
+// no request body.
+//
+//	type Domain struct {
+//		Id   string `json:"id"`
+//		Name string `json:"name"`
+//	}
+//	domain, err := resend.Do[Domain](ctx, client, http.MethodGet, "domains/"+id, nil)
+func Do[T any](ctx context.Context, client *Client, method, path string, body any, opts ...RequestOption) (T, error) {
+	var ret T
+	if client == nil {
+		return ret, errors.New("[ERROR]: client cannot be nil")
+	}
+	if path == "" {
+		return ret, errors.New("[ERROR]: path cannot be empty")
+	}
+
+	req, err := client.NewRequest(ctx, method, path, body)
+	if err != nil {
+		return ret, err
+	}
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	_, err = client.Perform(req, &ret)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return ret, nil
+}
+
+// List returns an iterator over every item of a cursor-paginated list
+// endpoint that is not wrapped by a service yet. Responses must have the
+// {"object": "list", "has_more": ..., "data": [...]} shape of ListResponse.
+//
+// id returns the cursor of an item, usually its Id field. Pages are fetched
+// lazily through Do, starting from the given pagination options, exactly
+// like the All methods of the services.
+//
+//	for item, err := range resend.List(ctx, client, "domains", nil, func(d Domain) string { return d.Id }) {
+//		...
+//	}
+func List[T any](ctx context.Context, client *Client, path string, options *ListOptions, id func(T) string, opts ...RequestOption) iter.Seq2[T, error] {
+	return paginate(ctx, listCursor(options), id,
+		func(ctx context.Context, cursor ListOptions) ([]T, bool, error) {
+			page := append(opts[:len(opts):len(opts)], WithQuery(paginationValues(cursor)))
+			resp, err := Do[ListResponse[T]](ctx, client, http.MethodGet, path, nil, page...)
+			return resp.Data, resp.HasMore, err
+		})
+}
@@ -0,0 +1,102 @@
+package resend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type widget struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func TestDo(t *testing.T) {
+	setup()
+	defer teardown()
+	client.ApiKey = "re_123"
+
+	var middlewareCalls int
+	client.Use(func(next Handler) Handler {
+		return func(req *http.Request, ret any) (*http.Response, error) {
+			middlewareCalls++
+			return next(req, ret)
+		}
+	})
+
+	mux.HandleFunc("/widgets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		assert.Equal(t, "Bearer re_123", r.Header.Get("Authorization"))
+		assert.Equal(t, "key-1", r.Header.Get("Idempotency-Key"))
+		assert.Equal(t, "v2", r.Header.Get("X-Version"))
+		assert.Equal(t, "true", r.URL.Query().Get("dry_run"))
+
+		var body widget
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "Gear", body.Name)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(widget{Id: "w_1", Name: body.Name})
+	})
+
+	got, err := Do[widget](context.Background(), client, http.MethodPost, "widgets", &widget{Name: "Gear"},
+		WithIdempotencyKey("key-1"),
+		WithRequestHeader("X-Version", "v2"),
+		WithQuery(url.Values{"dry_run": {"true"}}))
+	assert.NoError(t, err)
+	assert.Equal(t, widget{Id: "w_1", Name: "Gear"}, got)
+	assert.Equal(t, 1, middlewareCalls)
+}
+
+func TestDoError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/widgets/missing", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"name":"not_found","message":"Widget not found"}`))
+	})
+
+	got, err := Do[*widget](context.Background(), client, http.MethodGet, "widgets/missing", nil)
+	assert.Nil(t, got)
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "Widget not found", apiErr.Message)
+
+	_, err = Do[widget](context.Background(), client, http.MethodGet, "", nil)
+	assert.Error(t, err)
+	_, err = Do[widget](context.Background(), nil, http.MethodGet, "widgets", nil)
+	assert.Error(t, err)
+}
+
+func TestList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	handler := pagedHandler(t, []string{"w5", "w4", "w3", "w2", "w1"}, &requests)
+	mux.HandleFunc("/widgets", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "blue", r.URL.Query().Get("color"))
+		handler(w, r)
+	})
+
+	id := func(w widget) string { return w.Id }
+	got := collectIds(t, List(context.Background(), client, "widgets", nil, id, WithQuery(url.Values{"color": {"blue"}})), id)
+	assert.Equal(t, []string{"w5", "w4", "w3", "w2", "w1"}, got)
+	assert.Equal(t, 3, requests)
+
+	requests = 0
+	before := "w1"
+	got = collectIds(t, List(context.Background(), client, "widgets?color=blue", &ListOptions{Before: &before}, id), id)
+	assert.Equal(t, []string{"w2", "w3", "w4", "w5"}, got)
+	assert.Equal(t, 2, requests)
+}
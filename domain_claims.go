@@ -47,6 +47,8 @@ type DomainClaim struct {
 	FailureReason string             `json:"failure_reason,omitempty"`
 	CreatedAt     string             `json:"created_at,omitempty"`
 	ExpiresAt     string             `json:"expires_at,omitempty"`
+
+	unknownFields
 }
 
 // CreateDomainClaimRequest contains params for starting a domain claim.
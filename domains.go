@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"iter"
 	"net/http"
 )
 
@@ -52,6 +53,7 @@ type DomainsSvc interface {
 	ListWithOptions(ctx context.Context, options *ListOptions) (ListDomainsResponse, error)
 	ListWithContext(ctx context.Context) (ListDomainsResponse, error)
 	List() (ListDomainsResponse, error)
+	All(ctx context.Context, options *ListOptions) iter.Seq2[Domain, error]
 	GetWithContext(ctx context.Context, domainId string) (Domain, error)
 	Get(domainId string) (Domain, error)
 	RemoveWithContext(ctx context.Context, domainId string) (bool, error)
@@ -87,23 +89,49 @@ type CreateDomainRequest struct {
 }
 
 type CreateDomainResponse struct {
-	Id                string   `json:"id"`
-	Name              string   `json:"name"`
-	CreatedAt         string   `json:"createdAt"`
-	Status            string   `json:"status"`
-	Records           []Record `json:"records"`
-	Region            string   `json:"region"`
-	DnsProvider       string   `json:"dnsProvider"`
+	Id                string              `json:"id"`
+	Name              string              `json:"name"`
+	CreatedAt         string              `json:"createdAt"`
+	Status            string              `json:"status"`
+	Records           []Record            `json:"records"`
+	Region            string              `json:"region"`
+	DnsProvider       string              `json:"dnsProvider"`
 	OpenTracking      bool                `json:"open_tracking,omitempty"`
 	ClickTracking     bool                `json:"click_tracking,omitempty"`
 	TrackingSubdomain string              `json:"tracking_subdomain,omitempty"`
 	Capabilities      *DomainCapabilities `json:"capabilities,omitempty"`
+
+	unknownFields
+}
+
+// UnmarshalJSON decodes the creation time from either created_at, as
+// returned for every other resource, or the legacy createdAt key.
+func (r *CreateDomainResponse) UnmarshalJSON(data []byte) error {
+	type alias CreateDomainResponse
+	aux := struct {
+		*alias
+		CreatedAtSnake string `json:"created_at"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if r.CreatedAt == "" {
+		r.CreatedAt = aux.CreatedAtSnake
+	}
+	return nil
+}
+
+// jsonAliases keeps created_at out of the Extra fields
+func (r *CreateDomainResponse) jsonAliases() []string {
+	return []string{"created_at"}
 }
 
 type ListDomainsResponse struct {
 	Object  string   `json:"object"`
 	Data    []Domain `json:"data"`
 	HasMore bool     `json:"has_more"`
+
+	unknownFields
 }
 
 type UpdateDomainRequest struct {
@@ -147,17 +175,19 @@ func (r UpdateDomainRequest) MarshalJSON() ([]byte, error) {
 }
 
 type Domain struct {
-	Id                string   `json:"id,omitempty"`
-	Object            string   `json:"object,omitempty"`
-	Name              string   `json:"name,omitempty"`
-	CreatedAt         string   `json:"created_at,omitempty"`
-	Status            string   `json:"status,omitempty"`
-	Region            string   `json:"region,omitempty"`
-	Records           []Record `json:"records,omitempty"`
+	Id                string              `json:"id,omitempty"`
+	Object            string              `json:"object,omitempty"`
+	Name              string              `json:"name,omitempty"`
+	CreatedAt         string              `json:"created_at,omitempty"`
+	Status            string              `json:"status,omitempty"`
+	Region            string              `json:"region,omitempty"`
+	Records           []Record            `json:"records,omitempty"`
 	OpenTracking      bool                `json:"open_tracking,omitempty"`
 	ClickTracking     bool                `json:"click_tracking,omitempty"`
 	TrackingSubdomain string              `json:"tracking_subdomain,omitempty"`
 	Capabilities      *DomainCapabilities `json:"capabilities,omitempty"`
+
+	unknownFields
 }
 
 type Record struct {
@@ -290,6 +320,16 @@ func (s *DomainsSvcImpl) List() (ListDomainsResponse, error) {
 	return s.ListWithContext(context.Background())
 }
 
+// All iterates over all domains, fetching pages lazily
+// https://resend.com/docs/api-reference/domains/list-domains
+func (s *DomainsSvcImpl) All(ctx context.Context, options *ListOptions) iter.Seq2[Domain, error] {
+	return paginate(ctx, listCursor(options), func(item Domain) string { return item.Id },
+		func(ctx context.Context, cursor ListOptions) ([]Domain, bool, error) {
+			resp, err := s.ListWithOptions(ctx, &cursor)
+			return resp.Data, resp.HasMore, err
+		})
+}
+
 // RemoveWithContext removes a given domain entry by id
 // https://resend.com/docs/api-reference/domains/delete-domain
 func (s *DomainsSvcImpl) RemoveWithContext(ctx context.Context, domainId string) (bool, error) {
@@ -0,0 +1,239 @@
+package resend
+
+import (
+	"io"
+	"maps"
+	"net/mail"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// plainNamePattern matches the display names which need neither quoting nor
+// encoding
+var plainNamePattern = regexp.MustCompile(`^[a-zA-Z0-9!#$%&'*+\-/=?^_{|}~]+( [a-zA-Z0-9!#$%&'*+\-/=?^_{|}~]+)*$`)
+
+// EmailBuilder builds a SendEmailRequest from net/mail addresses, formatting
+// and deduplicating the recipients:
+//
+//	params, err := resend.NewEmail().
+//		From(mail.Address{Name: "Acme", Address: "onboarding@resend.dev"}).
+//		To(mail.Address{Name: "Jane Doe", Address: "jane@example.com"}).
+//		Subject("Welcome").
+//		Html("<p>Welcome!</p>").
+//		Build()
+//
+// The methods return the builder to chain calls, the problems are reported
+// by Build.
+type EmailBuilder struct {
+	from    *mail.Address
+	to      []mail.Address
+	cc      []mail.Address
+	bcc     []mail.Address
+	replyTo []mail.Address
+	req     SendEmailRequest
+
+	// scheduleErr is the problem with the time given to ScheduleAt
+	scheduleErr error
+}
+
+// NewEmail returns an empty EmailBuilder
+func NewEmail() *EmailBuilder {
+	return &EmailBuilder{}
+}
+
+// Clone returns a copy of the builder, ie: to build several emails of a
+// batch from a common base
+func (b *EmailBuilder) Clone() *EmailBuilder {
+	c := *b
+	if b.from != nil {
+		from := *b.from
+		c.from = &from
+	}
+	c.to = slices.Clone(b.to)
+	c.cc = slices.Clone(b.cc)
+	c.bcc = slices.Clone(b.bcc)
+	c.replyTo = slices.Clone(b.replyTo)
+	c.req.Tags = slices.Clone(b.req.Tags)
+	c.req.Attachments = slices.Clone(b.req.Attachments)
+	c.req.Headers = maps.Clone(b.req.Headers)
+	if b.req.Template != nil {
+		c.req.Template = &EmailTemplate{Id: b.req.Template.Id, Variables: maps.Clone(b.req.Template.Variables)}
+	}
+	return &c
+}
+
+// From sets the sender
+func (b *EmailBuilder) From(addr mail.Address) *EmailBuilder {
+	b.from = &addr
+	return b
+}
+
+// To adds recipients
+func (b *EmailBuilder) To(addrs ...mail.Address) *EmailBuilder {
+	b.to = append(b.to, addrs...)
+	return b
+}
+
+// Cc adds carbon copy recipients
+func (b *EmailBuilder) Cc(addrs ...mail.Address) *EmailBuilder {
+	b.cc = append(b.cc, addrs...)
+	return b
+}
+
+// Bcc adds blind carbon copy recipients
+func (b *EmailBuilder) Bcc(addrs ...mail.Address) *EmailBuilder {
+	b.bcc = append(b.bcc, addrs...)
+	return b
+}
+
+// ReplyTo adds reply-to addresses
+func (b *EmailBuilder) ReplyTo(addrs ...mail.Address) *EmailBuilder {
+	b.replyTo = append(b.replyTo, addrs...)
+	return b
+}
+
+// Subject sets the subject
+func (b *EmailBuilder) Subject(subject string) *EmailBuilder {
+	b.req.Subject = subject
+	return b
+}
+
+// Html sets the HTML body
+func (b *EmailBuilder) Html(html string) *EmailBuilder {
+	b.req.Html = html
+	return b
+}
+
+// Text sets the plain text body
+func (b *EmailBuilder) Text(text string) *EmailBuilder {
+	b.req.Text = text
+	return b
+}
+
+// Template sends the email with a published template, populated with the
+// given variables
+func (b *EmailBuilder) Template(id string, variables map[string]any) *EmailBuilder {
+	b.req.Template = &EmailTemplate{Id: id, Variables: variables}
+	return b
+}
+
+// Tag adds a tag
+func (b *EmailBuilder) Tag(name, value string) *EmailBuilder {
+	b.req.Tags = append(b.req.Tags, Tag{Name: name, Value: value})
+	return b
+}
+
+// Header sets a custom header, replacing any previous value
+func (b *EmailBuilder) Header(key, value string) *EmailBuilder {
+	if b.req.Headers == nil {
+		b.req.Headers = map[string]string{}
+	}
+	b.req.Headers[key] = value
+	return b
+}
+
+// Attach adds an attachment with the given content
+func (b *EmailBuilder) Attach(filename string, content []byte) *EmailBuilder {
+	return b.Attachment(&Attachment{Filename: filename, Content: content})
+}
+
+// AttachReader adds an attachment streamed from r while the request is sent
+func (b *EmailBuilder) AttachReader(filename string, r io.Reader) *EmailBuilder {
+	return b.Attachment(&Attachment{Filename: filename, Reader: r})
+}
+
+// AttachPath adds an attachment hosted at the given URL
+func (b *EmailBuilder) AttachPath(filename, path string) *EmailBuilder {
+	return b.Attachment(&Attachment{Filename: filename, Path: path})
+}
+
+// Inline adds an inline image, referenced in the HTML body with
+// <img src="cid:contentId">
+func (b *EmailBuilder) Inline(contentId, filename string, content []byte) *EmailBuilder {
+	return b.Attachment(&Attachment{Filename: filename, Content: content, ContentId: contentId})
+}
+
+// Attachment adds an attachment
+func (b *EmailBuilder) Attachment(a *Attachment) *EmailBuilder {
+	b.req.Attachments = append(b.req.Attachments, a)
+	return b
+}
+
+// ScheduleAt schedules the email to be sent at the given time, within
+// MaxScheduleHorizon. A time in the past or beyond the horizon is reported
+// by Build.
+func (b *EmailBuilder) ScheduleAt(t time.Time) *EmailBuilder {
+	b.req.ScheduledAt, b.scheduleErr = schedule(t)
+	return b
+}
+
+// TopicId sets the topic the email belongs to
+func (b *EmailBuilder) TopicId(id string) *EmailBuilder {
+	b.req.TopicId = id
+	return b
+}
+
+// Build returns the request, validated with SendEmailRequest.Validate.
+// Recipients are deduplicated across To, Cc and Bcc, ignoring the display
+// names and the case of the addresses: an address is kept in the first of
+// To, Cc and Bcc it was added to.
+func (b *EmailBuilder) Build() (*SendEmailRequest, error) {
+	if b.scheduleErr != nil {
+		return nil, b.scheduleErr
+	}
+
+	req := b.req
+	req.Tags = slices.Clone(b.req.Tags)
+	req.Attachments = slices.Clone(b.req.Attachments)
+	req.Headers = maps.Clone(b.req.Headers)
+
+	if b.from != nil {
+		req.From = formatAddress(*b.from)
+	}
+
+	seen := map[string]bool{}
+	req.To = dedupeAddresses(b.to, seen)
+	req.Cc = dedupeAddresses(b.cc, seen)
+	req.Bcc = dedupeAddresses(b.bcc, seen)
+
+	replyTo := dedupeAddresses(b.replyTo, map[string]bool{})
+	req.ReplyTo = strings.Join(replyTo, ", ")
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+// dedupeAddresses formats the addresses missing from seen, adding them to it
+func dedupeAddresses(addrs []mail.Address, seen map[string]bool) []string {
+	var formatted []string
+	for _, addr := range addrs {
+		key := strings.ToLower(strings.TrimSpace(addr.Address))
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		formatted = append(formatted, formatAddress(addr))
+	}
+	return formatted
+}
+
+// formatAddress formats an address as "Name <email@example.com>", quoting
+// and encoding the name when required by RFC 5322, or as a bare address
+// when it has no name. The domain is lowercased.
+func formatAddress(addr mail.Address) string {
+	addr.Address = strings.TrimSpace(addr.Address)
+	if i := strings.LastIndexByte(addr.Address, '@'); i >= 0 {
+		addr.Address = addr.Address[:i] + strings.ToLower(addr.Address[i:])
+	}
+	if addr.Name == "" {
+		return addr.Address
+	}
+	if plainNamePattern.MatchString(addr.Name) {
+		return addr.Name + " <" + addr.Address + ">"
+	}
+	return addr.String()
+}
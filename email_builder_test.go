@@ -0,0 +1,147 @@
+package resend
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmailBuilder(t *testing.T) {
+	fixClock(t, time.Date(2025, 12, 20, 0, 0, 0, 0, time.UTC))
+	at := time.Date(2026, 1, 2, 15, 4, 5, 0, time.FixedZone("CET", 3600))
+
+	params, err := NewEmail().
+		From(mail.Address{Name: "Acme, Inc.", Address: "onboarding@Resend.dev"}).
+		To(mail.Address{Name: "Jane", Address: "jane@example.com"}, mail.Address{Address: "john@example.com"}).
+		Cc(mail.Address{Address: "JANE@example.com"}, mail.Address{Name: "Zoë", Address: "zoe@example.com"}).
+		Bcc(mail.Address{Address: "zoe@EXAMPLE.com"}, mail.Address{Address: "audit@example.com"}).
+		ReplyTo(mail.Address{Address: "support@example.com"}).
+		Subject("Welcome").
+		Html(`<p>Welcome!</p><img src="cid:logo">`).
+		Text("Welcome!").
+		Tag("category", "welcome").
+		Header("X-Entity-Ref-ID", "123").
+		Attach("invoice.pdf", []byte("%PDF")).
+		AttachReader("report.csv", strings.NewReader("a,b")).
+		AttachPath("terms.pdf", "https://example.com/terms.pdf").
+		Inline("logo", "logo.png", []byte("png")).
+		ScheduleAt(at).
+		Build()
+	assert.NoError(t, err)
+
+	assert.Equal(t, `"Acme, Inc." <onboarding@resend.dev>`, params.From)
+	assert.Equal(t, []string{"Jane <jane@example.com>", "john@example.com"}, params.To)
+	assert.Equal(t, []string{"=?utf-8?q?Zo=C3=AB?= <zoe@example.com>"}, params.Cc)
+	assert.Equal(t, []string{"audit@example.com"}, params.Bcc)
+	assert.Equal(t, "support@example.com", params.ReplyTo)
+	assert.Equal(t, []Tag{{Name: "category", Value: "welcome"}}, params.Tags)
+	assert.Equal(t, map[string]string{"X-Entity-Ref-ID": "123"}, params.Headers)
+	assert.Len(t, params.Attachments, 4)
+	assert.Equal(t, "logo", params.Attachments[3].ContentId)
+	assert.Equal(t, "2026-01-02T14:04:05Z", params.ScheduledAt)
+
+	for _, addr := range append(append(params.To, params.Cc...), params.From) {
+		_, err := mail.ParseAddress(addr)
+		assert.NoError(t, err)
+	}
+}
+
+func TestEmailBuilderTemplate(t *testing.T) {
+	params, err := NewEmail().
+		From(mail.Address{Address: "onboarding@resend.dev"}).
+		To(mail.Address{Address: "jane@example.com"}).
+		Template("welcome", map[string]any{"name": "Jane"}).
+		Build()
+	assert.NoError(t, err)
+	assert.Equal(t, &EmailTemplate{Id: "welcome", Variables: map[string]any{"name": "Jane"}}, params.Template)
+}
+
+func TestEmailBuilderValidates(t *testing.T) {
+	_, err := NewEmail().
+		From(mail.Address{Address: "onboarding@resend.dev"}).
+		Subject("Hello").
+		Html("<p>Hello</p>").
+		Template("welcome", nil).
+		Build()
+
+	var validationErr *ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, []string{"to", "html"}, fields(err))
+}
+
+func TestEmailBuilderChecksScheduleAt(t *testing.T) {
+	now := time.Date(2024, 8, 5, 11, 0, 0, 0, time.UTC)
+	fixClock(t, now)
+
+	build := func(at time.Time) error {
+		_, err := NewEmail().
+			From(mail.Address{Address: "onboarding@resend.dev"}).
+			To(mail.Address{Address: "jane@example.com"}).
+			Subject("Hello").
+			Html("<p>Hello</p>").
+			ScheduleAt(at).
+			Build()
+		return err
+	}
+
+	assert.Equal(t, []string{"scheduled_at"}, fields(build(now.Add(-time.Minute))))
+	assert.Equal(t, []string{"scheduled_at"}, fields(build(now.Add(MaxScheduleHorizon+time.Hour))))
+	assert.True(t, errors.Is(build(now.Add(-time.Minute)), ErrValidation))
+	assert.NoError(t, build(now.Add(time.Hour)))
+
+	// A later valid time replaces the invalid one
+	params, err := NewEmail().
+		From(mail.Address{Address: "onboarding@resend.dev"}).
+		To(mail.Address{Address: "jane@example.com"}).
+		Subject("Hello").
+		Html("<p>Hello</p>").
+		ScheduleAt(now.Add(-time.Minute)).
+		ScheduleAt(now.Add(time.Hour)).
+		Build()
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-08-05T12:00:00Z", params.ScheduledAt)
+}
+
+func TestEmailBuilderClone(t *testing.T) {
+	base := NewEmail().
+		From(mail.Address{Name: "Acme", Address: "onboarding@resend.dev"}).
+		Subject("Hello").
+		Html("<p>Hello</p>").
+		Header("X-Campaign", "spring")
+
+	jane := base.Clone().To(mail.Address{Address: "jane@example.com"}).Header("X-Campaign", "jane")
+	john := base.Clone().To(mail.Address{Address: "john@example.com"})
+
+	first, err := jane.Build()
+	assert.NoError(t, err)
+	second, err := john.Build()
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"jane@example.com"}, first.To)
+	assert.Equal(t, "jane", first.Headers["X-Campaign"])
+	assert.Equal(t, []string{"john@example.com"}, second.To)
+	assert.Equal(t, "spring", second.Headers["X-Campaign"])
+
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/emails/batch", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var sent []map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&sent))
+		assert.Len(t, sent, 2)
+		assert.Equal(t, "Acme <onboarding@resend.dev>", sent[0]["from"])
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[{"id":"1"},{"id":"2"}]}`))
+	})
+
+	resp, err := client.Batch.Send([]*SendEmailRequest{first, second})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Data, 2)
+}
@@ -2,7 +2,10 @@ package resend
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"io"
+	"iter"
 	"net/http"
 )
 
@@ -46,6 +49,8 @@ type SendEmailRequest struct {
 type CancelScheduledEmailResponse struct {
 	Id     string `json:"id"`
 	Object string `json:"object"`
+
+	unknownFields
 }
 
 // ShareEmailRequest is the request object for the Share call.
@@ -58,11 +63,15 @@ type ShareEmailResponse struct {
 	Id     string `json:"id"`
 	Object string `json:"object"`
 	Url    string `json:"url"`
+
+	unknownFields
 }
 
 // SendEmailResponse is the response from the Send call.
 type SendEmailResponse struct {
 	Id string `json:"id"`
+
+	unknownFields
 }
 
 // UpdateEmailRequest is the request object for the Update call.
@@ -75,23 +84,62 @@ type UpdateEmailRequest struct {
 type UpdateEmailResponse struct {
 	Id     string `json:"id"`
 	Object string `json:"object"`
+
+	unknownFields
 }
 
 // Email provides the structure for the response from the Get call.
 type Email struct {
-	Id        string   `json:"id"`
-	Object    string   `json:"object"`
-	MessageId string   `json:"message_id"`
-	To        []string `json:"to"`
-	From      string   `json:"from"`
-	CreatedAt string   `json:"created_at"`
-	Subject   string   `json:"subject"`
-	Html      string   `json:"html"`
-	Text      string   `json:"text"`
-	Bcc       []string `json:"bcc"`
-	Cc        []string `json:"cc"`
-	ReplyTo   []string `json:"reply_to"`
-	LastEvent string   `json:"last_event"`
+	Id        string     `json:"id"`
+	Object    string     `json:"object"`
+	MessageId string     `json:"message_id"`
+	To        []string   `json:"to"`
+	From      string     `json:"from"`
+	CreatedAt string     `json:"created_at"`
+	Subject   string     `json:"subject"`
+	Html      string     `json:"html"`
+	Text      string     `json:"text"`
+	Bcc       []string   `json:"bcc"`
+	Cc        []string   `json:"cc"`
+	ReplyTo   []string   `json:"reply_to"`
+	LastEvent EmailEvent `json:"last_event"`
+
+	// ScheduledAt is the time the email is scheduled for, see ScheduledTime
+	ScheduledAt string `json:"scheduled_at,omitempty"`
+
+	unknownFields
+}
+
+// EmailEvent is the last event of an email, ie: delivered
+type EmailEvent string
+
+const (
+	EmailEventQueued          EmailEvent = "queued"
+	EmailEventScheduled       EmailEvent = "scheduled"
+	EmailEventSent            EmailEvent = "sent"
+	EmailEventDelivered       EmailEvent = "delivered"
+	EmailEventDeliveryDelayed EmailEvent = "delivery_delayed"
+	EmailEventOpened          EmailEvent = "opened"
+	EmailEventClicked         EmailEvent = "clicked"
+	EmailEventBounced         EmailEvent = "bounced"
+	EmailEventComplained      EmailEvent = "complained"
+	EmailEventFailed          EmailEvent = "failed"
+	EmailEventSuppressed      EmailEvent = "suppressed"
+	EmailEventCanceled        EmailEvent = "canceled"
+)
+
+// IsValid reports whether the EmailEvent is one of the known values
+func (e EmailEvent) IsValid() bool {
+	switch e {
+	case EmailEventQueued, EmailEventScheduled, EmailEventSent, EmailEventDelivered, EmailEventDeliveryDelayed, EmailEventOpened, EmailEventClicked, EmailEventBounced, EmailEventComplained, EmailEventFailed, EmailEventSuppressed, EmailEventCanceled:
+		return true
+	}
+	return false
+}
+
+// String returns the string representation of the EmailEvent
+func (e EmailEvent) String() string {
+	return string(e)
 }
 
 // ListEmailsResponse is the response from the List call.
@@ -99,6 +147,8 @@ type ListEmailsResponse struct {
 	Object  string  `json:"object"`
 	HasMore bool    `json:"has_more"`
 	Data    []Email `json:"data"`
+
+	unknownFields
 }
 
 // Tags are used to define custom metadata for emails
@@ -119,6 +169,8 @@ type EmailAttachment struct {
 	ContentId          string `json:"content_id"`
 	DownloadUrl        string `json:"download_url"`
 	ExpiresAt          string `json:"expires_at"`
+
+	unknownFields
 }
 
 // ListEmailAttachmentsResponse is the response from the ListAttachments call.
@@ -126,6 +178,8 @@ type ListEmailAttachmentsResponse struct {
 	Object  string            `json:"object"`
 	HasMore bool              `json:"has_more"`
 	Data    []EmailAttachment `json:"data"`
+
+	unknownFields
 }
 
 // Attachment is the public struct used for adding attachments to emails
@@ -134,6 +188,12 @@ type Attachment struct {
 	// is not available.
 	Content []byte
 
+	// Reader streams the content of the attachment instead of Content.
+	// It is base64 encoded on the fly while the request is sent, so large
+	// files are never held in memory. Requests are only retried when every
+	// Reader of the request also implements io.Seeker, ie: an *os.File.
+	Reader io.Reader
+
 	// Filename that will appear in the email.
 	// Make sure you pick the correct extension otherwise preview
 	// may not work as expected
@@ -157,13 +217,19 @@ type Attachment struct {
 	// If set, this attachment will be sent as an inline attachment and you can reference it
 	// in the HTML content using the `cid:` prefix.
 	InlineContentId string
+
+	// streamToken replaces the content in the JSON encoding while the
+	// request body is streamed, see newStreamingBody.
+	streamToken string
 }
 
 // MarshalJSON overrides the regular JSON Marshaller to ensure that the
-// attachment content is provided in the way Resend expects.
+// attachment content is provided in the way Resend expects, as a base64
+// encoded string. When called directly on an attachment with a Reader, the
+// Reader is read in full.
 func (a *Attachment) MarshalJSON() ([]byte, error) {
 	na := struct {
-		Content         []int  `json:"content,omitempty"`
+		Content         string `json:"content,omitempty"`
 		Filename        string `json:"filename,omitempty"`
 		Path            string `json:"path,omitempty"`
 		ContentType     string `json:"content_type,omitempty"`
@@ -172,14 +238,76 @@ func (a *Attachment) MarshalJSON() ([]byte, error) {
 	}{
 		Filename:        a.Filename,
 		Path:            a.Path,
-		Content:         BytesToIntArray(a.Content),
 		ContentType:     a.ContentType,
 		ContentId:       a.ContentId,
 		InlineContentId: a.InlineContentId,
 	}
+
+	switch {
+	case a.streamToken != "":
+		na.Content = a.streamToken
+	case a.Reader != nil:
+		content, err := io.ReadAll(a.Reader)
+		if err != nil {
+			return nil, err
+		}
+		na.Content = base64.StdEncoding.EncodeToString(content)
+	default:
+		na.Content = base64.StdEncoding.EncodeToString(a.Content)
+	}
 	return json.Marshal(na)
 }
 
+// UnmarshalJSON decodes an attachment in the format sent to Resend, with
+// the content as a base64 encoded string or as an array of bytes.
+func (a *Attachment) UnmarshalJSON(data []byte) error {
+	na := struct {
+		Content         json.RawMessage `json:"content"`
+		Filename        string          `json:"filename"`
+		Path            string          `json:"path"`
+		ContentType     string          `json:"content_type"`
+		ContentId       string          `json:"content_id"`
+		InlineContentId string          `json:"inline_content_id"`
+	}{}
+	if err := json.Unmarshal(data, &na); err != nil {
+		return err
+	}
+
+	*a = Attachment{
+		Filename:        na.Filename,
+		Path:            na.Path,
+		ContentType:     na.ContentType,
+		ContentId:       na.ContentId,
+		InlineContentId: na.InlineContentId,
+	}
+	if len(na.Content) == 0 || string(na.Content) == "null" {
+		return nil
+	}
+
+	if na.Content[0] == '[' {
+		var ints []int
+		if err := json.Unmarshal(na.Content, &ints); err != nil {
+			return err
+		}
+		a.Content = make([]byte, len(ints))
+		for i, v := range ints {
+			a.Content[i] = byte(v)
+		}
+		return nil
+	}
+
+	var content string
+	if err := json.Unmarshal(na.Content, &content); err != nil {
+		return err
+	}
+	decoded, err := base64.StdEncoding.DecodeString(content)
+	if err != nil {
+		return err
+	}
+	a.Content = decoded
+	return nil
+}
+
 type EmailsSvc interface {
 	CancelWithContext(ctx context.Context, emailId string) (*CancelScheduledEmailResponse, error)
 	Cancel(emailId string) (*CancelScheduledEmailResponse, error)
@@ -192,6 +320,7 @@ type EmailsSvc interface {
 	Send(params *SendEmailRequest) (*SendEmailResponse, error)
 	GetWithContext(ctx context.Context, emailId string) (*Email, error)
 	Get(emailId string) (*Email, error)
+	WaitForStatus(ctx context.Context, emailId string, options *WaitForStatusOptions) (*WaitForStatusResponse, error)
 
 	// Both List and ListWithOptions do the same thing, but since these List methods
 	// were introduced after some time, we kept both for overall consistency with
@@ -199,6 +328,7 @@ type EmailsSvc interface {
 	ListWithOptions(ctx context.Context, options *ListOptions) (ListEmailsResponse, error)
 	ListWithContext(ctx context.Context) (ListEmailsResponse, error)
 	List() (ListEmailsResponse, error)
+	All(ctx context.Context, options *ListOptions) iter.Seq2[Email, error]
 
 	// Attachment methods for sent emails
 	GetAttachmentWithContext(ctx context.Context, emailId string, attachmentId string) (*EmailAttachment, error)
@@ -206,6 +336,7 @@ type EmailsSvc interface {
 	ListAttachmentsWithOptions(ctx context.Context, emailId string, options *ListOptions) (ListEmailAttachmentsResponse, error)
 	ListAttachmentsWithContext(ctx context.Context, emailId string) (ListEmailAttachmentsResponse, error)
 	ListAttachments(emailId string) (ListEmailAttachmentsResponse, error)
+	AllAttachments(ctx context.Context, emailId string, options *ListOptions) iter.Seq2[EmailAttachment, error]
 }
 
 type EmailsSvcImpl struct {
@@ -308,6 +439,12 @@ func (s *EmailsSvcImpl) UpdateWithContext(ctx context.Context, params *UpdateEma
 // and additional options
 // https://resend.com/docs/api-reference/emails/send-email
 func (s *EmailsSvcImpl) SendWithOptions(ctx context.Context, params *SendEmailRequest, options *SendEmailOptions) (*SendEmailResponse, error) {
+	if s.client.ValidateRequests {
+		if err := params.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	path := "emails"
 
 	// Prepare request
@@ -332,6 +469,12 @@ func (s *EmailsSvcImpl) SendWithOptions(ctx context.Context, params *SendEmailRe
 // SendWithContext sends an email with the given params
 // https://resend.com/docs/api-reference/emails/send-email
 func (s *EmailsSvcImpl) SendWithContext(ctx context.Context, params *SendEmailRequest) (*SendEmailResponse, error) {
+	if s.client.ValidateRequests {
+		if err := params.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	path := "emails"
 
 	// Prepare request
@@ -425,6 +568,16 @@ func (s *EmailsSvcImpl) List() (ListEmailsResponse, error) {
 	return s.ListWithContext(context.Background())
 }
 
+// All iterates over all emails, fetching pages lazily
+// https://resend.com/docs/api-reference/emails/list-emails
+func (s *EmailsSvcImpl) All(ctx context.Context, options *ListOptions) iter.Seq2[Email, error] {
+	return paginate(ctx, listCursor(options), func(e Email) string { return e.Id },
+		func(ctx context.Context, cursor ListOptions) ([]Email, bool, error) {
+			resp, err := s.ListWithOptions(ctx, &cursor)
+			return resp.Data, resp.HasMore, err
+		})
+}
+
 // GetAttachmentWithContext retrieves a single attachment from a sent email with the given emailId and attachmentId
 // https://resend.com/docs/api-reference/attachments/retrieve-sent-email-attachment
 func (s *EmailsSvcImpl) GetAttachmentWithContext(ctx context.Context, emailId string, attachmentId string) (*EmailAttachment, error) {
@@ -489,3 +642,13 @@ func (s *EmailsSvcImpl) ListAttachmentsWithContext(ctx context.Context, emailId
 func (s *EmailsSvcImpl) ListAttachments(emailId string) (ListEmailAttachmentsResponse, error) {
 	return s.ListAttachmentsWithContext(context.Background(), emailId)
 }
+
+// AllAttachments iterates over all attachments of a sent email, fetching pages lazily
+// https://resend.com/docs/api-reference/attachments/list-sent-email-attachments
+func (s *EmailsSvcImpl) AllAttachments(ctx context.Context, emailId string, options *ListOptions) iter.Seq2[EmailAttachment, error] {
+	return paginate(ctx, listCursor(options), func(a EmailAttachment) string { return a.Id },
+		func(ctx context.Context, cursor ListOptions) ([]EmailAttachment, bool, error) {
+			resp, err := s.ListAttachmentsWithOptions(ctx, emailId, &cursor)
+			return resp.Data, resp.HasMore, err
+		})
+}
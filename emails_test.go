@@ -100,7 +100,7 @@ func TestSendEmailWithAttachment(t *testing.T) {
 		if err != nil {
 			t.Errorf("failed to read request body: %v", err)
 		}
-		exp := `"attachments":[{"content":[104,101,108,108,111],"filename":"hello.txt","content_type":"text/plain"}]`
+		exp := `"attachments":[{"content":"aGVsbG8=","filename":"hello.txt","content_type":"text/plain"}]`
 		if !bytes.Contains(content, []byte(exp)) {
 			t.Errorf("request body does not include attachment data")
 		}
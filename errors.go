@@ -3,6 +3,7 @@ package resend
 import (
 	"errors"
 	"fmt"
+	"net/http"
 )
 
 // MissingRequiredFieldsError is used when a required field is missing before making an API request
@@ -46,6 +47,67 @@ func (e *RateLimitError) Is(target error) bool {
 	return target == ErrRateLimit
 }
 
+// Sentinel errors for API error detection with errors.Is
+var (
+	// ErrValidation matches 400 and 422 responses
+	ErrValidation = errors.New("validation error")
+	// ErrUnauthorized matches 401 responses
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden matches 403 responses
+	ErrForbidden = errors.New("forbidden")
+	// ErrNotFound matches 404 responses
+	ErrNotFound = errors.New("not found")
+	// ErrConflict matches 409 responses
+	ErrConflict = errors.New("conflict")
+	// ErrServer matches 5xx responses
+	ErrServer = errors.New("server error")
+)
+
+// APIError represents a non-2xx response returned by the Resend API
+type APIError struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+
+	// Name is the error name returned by the API, ie: "validation_error"
+	Name string
+
+	// Message is the error message from the API
+	Message string
+
+	// RequestId is the value of the x-request-id response header, if any
+	RequestId string
+
+	// Body is the raw response body
+	Body []byte
+
+	// Header contains the response headers
+	Header http.Header
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	return "[ERROR]: " + e.Message
+}
+
+// Is implements errors.Is support for matching the status code sentinels
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrServer:
+		return e.StatusCode >= 500
+	}
+	return false
+}
+
 // BroadcastsSvc errors
 var (
 	ErrFailedToCreateBroadcastUpdateRequest = errors.New("[ERROR]: Failed to create Broadcasts.Update request")
@@ -2,6 +2,7 @@ package resend
 
 import (
 	"context"
+	"iter"
 	"net/http"
 )
 
@@ -22,6 +23,8 @@ type CreateEventRequest struct {
 type CreateEventResponse struct {
 	Object string `json:"object"`
 	Id     string `json:"id"`
+
+	unknownFields
 }
 
 type UpdateEventRequest struct {
@@ -31,12 +34,16 @@ type UpdateEventRequest struct {
 type UpdateEventResponse struct {
 	Object string `json:"object"`
 	Id     string `json:"id"`
+
+	unknownFields
 }
 
 type DeleteEventResponse struct {
 	Object  string `json:"object"`
 	Id      string `json:"id"`
 	Deleted bool   `json:"deleted"`
+
+	unknownFields
 }
 
 type SendEventRequest struct {
@@ -49,6 +56,8 @@ type SendEventRequest struct {
 type SendEventResponse struct {
 	Object string `json:"object"`
 	Event  string `json:"event"`
+
+	unknownFields
 }
 
 type EventSummary struct {
@@ -57,12 +66,16 @@ type EventSummary struct {
 	Schema    map[string]string `json:"schema"`
 	CreatedAt string            `json:"created_at"`
 	UpdatedAt *string           `json:"updated_at"`
+
+	unknownFields
 }
 
 type ListEventsResponse struct {
 	Object  string         `json:"object"`
 	HasMore bool           `json:"has_more"`
 	Data    []EventSummary `json:"data"`
+
+	unknownFields
 }
 
 type Event struct {
@@ -72,6 +85,8 @@ type Event struct {
 	Schema    map[string]string `json:"schema"`
 	CreatedAt string            `json:"created_at"`
 	UpdatedAt *string           `json:"updated_at"`
+
+	unknownFields
 }
 
 type EventsSvc interface {
@@ -81,6 +96,7 @@ type EventsSvc interface {
 	Get(identifier string) (Event, error)
 	ListWithContext(ctx context.Context) (ListEventsResponse, error)
 	List() (ListEventsResponse, error)
+	All(ctx context.Context, options *ListOptions) iter.Seq2[EventSummary, error]
 	ListWithOptions(ctx context.Context, options *ListOptions) (ListEventsResponse, error)
 	UpdateWithContext(ctx context.Context, identifier string, params *UpdateEventRequest) (UpdateEventResponse, error)
 	Update(identifier string, params *UpdateEventRequest) (UpdateEventResponse, error)
@@ -170,6 +186,16 @@ func (s *EventsSvcImpl) List() (ListEventsResponse, error) {
 	return s.ListWithContext(context.Background())
 }
 
+// All iterates over all events, fetching pages lazily
+// https://resend.com/docs/api-reference/events/list-events
+func (s *EventsSvcImpl) All(ctx context.Context, options *ListOptions) iter.Seq2[EventSummary, error] {
+	return paginate(ctx, listCursor(options), func(item EventSummary) string { return item.Id },
+		func(ctx context.Context, cursor ListOptions) ([]EventSummary, bool, error) {
+			resp, err := s.ListWithOptions(ctx, &cursor)
+			return resp.Data, resp.HasMore, err
+		})
+}
+
 // UpdateWithContext updates an Event's schema by ID or name
 // https://resend.com/docs/api-reference/events/update-event
 func (s *EventsSvcImpl) UpdateWithContext(ctx context.Context, identifier string, params *UpdateEventRequest) (UpdateEventResponse, error) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/resend/resend-go/v3"
 )
@@ -29,9 +30,10 @@ func scheduleEmail() {
 	}
 	fmt.Println(sent.Id)
 
-	updateParams := &resend.UpdateEmailRequest{
-		Id:          sent.Id,
-		ScheduledAt: "2024-11-05T11:52:01.858Z",
+	// Reschedule it in 2 hours, checked against the scheduling horizon
+	updateParams := &resend.UpdateEmailRequest{Id: sent.Id}
+	if err := updateParams.ScheduleIn(2 * time.Hour); err != nil {
+		panic(err)
 	}
 
 	// Update the scheduled email
@@ -41,6 +43,16 @@ func scheduleEmail() {
 	}
 	fmt.Printf("%v\n", updatedEmail)
 
+	email, err := client.Emails.GetWithContext(ctx, sent.Id)
+	if err != nil {
+		panic(err)
+	}
+	scheduledAt, err := email.ScheduledTime()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(scheduledAt.Local())
+
 	canceled, err := client.Emails.CancelWithContext(ctx, "32723fee-8502-4b58-8b5e-bfd98f453ced")
 	if err != nil {
 		panic(err)
@@ -93,6 +93,27 @@ func sendBatchEmails() {
 		}
 	}
 
+	// Results are aligned with the emails of the batch
+	for i, result := range sent.Results {
+		if result.Failed() {
+			fmt.Printf("  - %q failed: %v\n", batchEmailsWithErrors[i].Subject, result.Error)
+		} else {
+			fmt.Printf("  - %q sent as %s\n", batchEmailsWithErrors[i].Subject, result.Id)
+		}
+	}
+
+	// Fix the rejected emails and send them again
+	if retry := sent.Retry(permissiveOptions); retry != nil {
+		for _, email := range retry.Emails {
+			email.To = []string{"delivered@resend.dev"}
+		}
+		retried, err := client.Batch.SendWithOptions(ctx, retry.Emails, retry.Options)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("Retried %d emails\n", len(retried.Data))
+	}
+
 	// Send with strict validation mode (default behavior)
 	// All emails must be valid or the entire batch fails
 	strictOptions := &resend.BatchSendEmailOptions{
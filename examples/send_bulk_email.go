@@ -0,0 +1,54 @@
+package examples
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/resend/resend-go/v3"
+)
+
+func sendBulkEmails() {
+	ctx := context.TODO()
+	apiKey := os.Getenv("RESEND_API_KEY")
+
+	client := resend.NewClient(apiKey)
+
+	// Any number of emails, sent in batches of 100
+	var emails []*resend.SendEmailRequest
+	for i := range 1000 {
+		emails = append(emails, &resend.SendEmailRequest{
+			To:      []string{fmt.Sprintf("user+%d@example.com", i)},
+			From:    "onboarding@resend.dev",
+			Text:    "hello world",
+			Subject: "Our monthly newsletter",
+		})
+	}
+
+	// Save the checkpoint to resume the job if it fails
+	checkpoint := 0
+	options := &resend.BulkOptions{
+		IdempotencyKey:  "newsletter-2024-08",
+		BatchValidation: resend.BatchValidationPermissive,
+		OnProgress: func(p resend.BulkProgress) {
+			checkpoint = p.Checkpoint
+			fmt.Printf("%d sent, %d rejected\n", p.Sent, p.Rejected)
+		},
+	}
+
+	result, err := resend.NewBulkSender(client.Batch, options).Send(ctx, emails)
+	var chunkErr *resend.BulkChunkError
+	if errors.As(err, &chunkErr) {
+		// Resume from the checkpoint, batches keep their idempotency keys
+		options.Offset = checkpoint
+		result, err = resend.NewBulkSender(client.Batch, options).Send(ctx, emails)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	for _, e := range result.Errors {
+		fmt.Printf("Email to %v rejected: %s\n", emails[e.Index].To, e.Message)
+	}
+}
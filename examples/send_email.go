@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/resend/resend-go/v3"
 )
@@ -49,6 +50,15 @@ func sendEmailExample() {
 	}
 	fmt.Printf("%v\n", email)
 
+	// Wait until the email is delivered, bounced or failed
+	status, err := client.Emails.WaitForStatus(ctx, sent.Id, &resend.WaitForStatusOptions{
+		Timeout: 2 * time.Minute,
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Email %s: %s\n", status.Email.Id, status.Outcome)
+
 	// List emails
 	fmt.Println("\nListing recent emails:")
 	listResp, err := client.Emails.ListWithContext(ctx)
@@ -38,7 +38,7 @@ func webhooksExample() {
 
 	// Update Webhook
 	newEndpoint := "https://new-webhook.example.com/handler"
-	newStatus := "disabled"
+	newStatus := resend.WebhookStatusDisabled
 	updateParams := &resend.UpdateWebhookRequest{
 		Endpoint: &newEndpoint,
 		Events:   []string{"email.sent", "email.delivered"},
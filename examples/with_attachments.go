@@ -32,6 +32,20 @@ func withAttachments() {
 		ContentType: "application/pdf",
 	}
 
+	// Large files can be streamed from an io.Reader instead of being read
+	// in memory. An *os.File is seekable, so the request can be retried.
+	file, err := os.Open(pwd + "/resources/invoice.pdf")
+	if err != nil {
+		panic(err)
+	}
+	defer file.Close()
+
+	pdfAttachmentFromReader := &resend.Attachment{
+		Reader:      file,
+		Filename:    "invoice3.pdf",
+		ContentType: "application/pdf",
+	}
+
 	pdfAttachmentFromRemotePath := &resend.Attachment{
 		Path:        "https://github.com/resend/resend-go/raw/main/resources/invoice.pdf",
 		Filename:    "invoice2.pdf",
@@ -44,7 +58,7 @@ func withAttachments() {
 		Text:        "email with attachments !!",
 		Html:        "<strong>email with attachments !!</strong>",
 		Subject:     "Email with attachment",
-		Attachments: []*resend.Attachment{pdfAttachmentFromLocalFile, pdfAttachmentFromRemotePath},
+		Attachments: []*resend.Attachment{pdfAttachmentFromLocalFile, pdfAttachmentFromRemotePath, pdfAttachmentFromReader},
 	}
 
 	sent, err := client.Emails.SendWithContext(ctx, params)
@@ -0,0 +1,45 @@
+package examples
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/resend/resend-go/v3"
+)
+
+func withClientOptionsExample() {
+	ctx := context.TODO()
+	apiKey := os.Getenv("RESEND_API_KEY")
+
+	// Options are validated when the client is built
+	client, err := resend.NewClientWithOptions(apiKey,
+		resend.WithBaseURL("https://api.resend.com"),
+		resend.WithTimeout(30*time.Second),
+		resend.WithHeader("X-Tenant", "acme"),
+		resend.WithUserAgentSuffix("my-app/1.0"),
+		resend.WithRetryPolicy(resend.DefaultRetryPolicy()),
+		resend.WithLogger(slog.Default()),
+		// Log bodies with API keys, email addresses, attachment content
+		// and webhook signing secrets redacted
+		resend.WithLogOptions(resend.LogOptions{LogBodies: true}),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	params := &resend.SendEmailRequest{
+		To:      []string{"delivered@resend.dev"},
+		From:    "onboarding@resend.dev",
+		Text:    "hello world",
+		Subject: "Hello from Golang",
+	}
+
+	sent, err := client.Emails.SendWithContext(ctx, params)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Email sent successfully: %s\n", sent.Id)
+}
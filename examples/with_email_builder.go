@@ -0,0 +1,44 @@
+package examples
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"os"
+	"time"
+
+	"github.com/resend/resend-go/v3"
+)
+
+func withEmailBuilderExample() {
+	apiKey := os.Getenv("RESEND_API_KEY")
+
+	client := resend.NewClient(apiKey)
+
+	// jane@example.com is only kept in To, recipients are deduplicated
+	params, err := resend.NewEmail().
+		From(mail.Address{Name: "Acme", Address: "onboarding@resend.dev"}).
+		To(mail.Address{Name: "Jane Doe", Address: "jane@example.com"}).
+		Bcc(mail.Address{Address: "jane@example.com"}, mail.Address{Address: "audit@example.com"}).
+		Subject("Your invoice").
+		Html(`<p>Thanks for your order!</p><img src="cid:logo">`).
+		Inline("logo", "logo.png", []byte("...")).
+		Attach("invoice.pdf", []byte("...")).
+		Tag("category", "invoice").
+		ScheduleAt(time.Now().Add(time.Hour)).
+		Build()
+
+	var validationErr *resend.ValidationError
+	if errors.As(err, &validationErr) {
+		for _, f := range validationErr.Fields {
+			fmt.Printf("%s: %s\n", f.Field, f.Message)
+		}
+		return
+	}
+
+	sent, err := client.Emails.Send(params)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(sent.Id)
+}
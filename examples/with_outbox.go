@@ -0,0 +1,67 @@
+package examples
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/resend/resend-go/v3"
+	"github.com/resend/resend-go/v3/outbox"
+)
+
+func withOutboxExample() {
+	ctx := context.TODO()
+	apiKey := os.Getenv("RESEND_API_KEY")
+
+	client := resend.NewClient(apiKey)
+
+	// Messages are synced to the file before Enqueue returns
+	store, err := outbox.OpenFileStore("outbox.log")
+	if err != nil {
+		panic(err)
+	}
+	defer store.Close()
+
+	box := outbox.New(store, client.Emails, &outbox.Options{
+		MaxAttempts: 8,
+		OnDead: func(msg *outbox.Message) {
+			fmt.Printf("Message %s failed: %s\n", msg.Id, msg.LastError)
+		},
+	})
+
+	// Drain the outbox in the background
+	go func() {
+		if err := box.Run(ctx); err != nil && ctx.Err() == nil {
+			panic(err)
+		}
+	}()
+
+	// Enqueuing twice with the same id stores the email once
+	msg, err := box.EnqueueWithId(ctx, "password-reset/8f14e45f", &resend.SendEmailRequest{
+		From:    "onboarding@resend.dev",
+		To:      []string{"delivered@resend.dev"},
+		Subject: "Reset your password",
+		Text:    "Follow the link to reset your password",
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(msg.Id, msg.State)
+
+	// Inspect the dead messages and send them again
+	dead, err := box.Dead(ctx)
+	if err != nil {
+		panic(err)
+	}
+	for _, msg := range dead {
+		if err := box.Requeue(ctx, msg.Id, nil); err != nil {
+			panic(err)
+		}
+	}
+
+	// Drop the messages sent more than a day ago from the file
+	if err := store.Compact(time.Now().Add(-24 * time.Hour)); err != nil {
+		panic(err)
+	}
+}
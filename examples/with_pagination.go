@@ -101,4 +101,24 @@ func withPaginationExample() {
 			fmt.Printf("  - %s (ID: %s)\n", broadcast.Name, broadcast.Id)
 		}
 	}
+
+	// Iterate over every contact, fetching pages lazily
+	fmt.Println("\n=== Iterate over all contacts ===")
+
+	for contact, err := range client.Contacts.All(ctx, &resend.ListContactsOptions{Limit: &limit20}) {
+		if err != nil {
+			log.Printf("Error listing contacts: %v", err)
+			break
+		}
+		fmt.Printf("  - %s (ID: %s)\n", contact.Email, contact.Id)
+	}
+
+	// Collect the first 50 emails, only fetching the pages needed
+	fmt.Println("\n=== Collect the first 50 emails ===")
+
+	emails, err := resend.Collect(client.Emails.All(ctx, nil), 50)
+	if err != nil {
+		log.Printf("Error listing emails: %v", err)
+	}
+	fmt.Printf("Collected %d emails\n", len(emails))
 }
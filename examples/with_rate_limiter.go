@@ -0,0 +1,45 @@
+package examples
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/resend/resend-go/v3"
+)
+
+func withRateLimiterExample() {
+	ctx := context.TODO()
+	apiKey := os.Getenv("RESEND_API_KEY")
+
+	client := resend.NewClient(apiKey)
+
+	// Pace every service sharing this client to 2 requests per second.
+	// The limiter adapts to the ratelimit-* headers returned by the API.
+	client.RateLimiter = resend.NewRateLimiter(2, 2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			params := &resend.SendEmailRequest{
+				To:      []string{"delivered@resend.dev"},
+				From:    "onboarding@resend.dev",
+				Text:    "hello world",
+				Subject: fmt.Sprintf("Hello #%d", i),
+			}
+
+			// Goroutines queue on the limiter instead of hitting 429 responses
+			sent, err := client.Emails.SendWithContext(ctx, params)
+			if err != nil {
+				fmt.Printf("Failed to send email #%d: %v\n", i, err)
+				return
+			}
+			fmt.Printf("Email #%d sent: %s\n", i, sent.Id)
+		}(i)
+	}
+	wg.Wait()
+}
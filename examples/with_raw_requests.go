@@ -0,0 +1,51 @@
+package examples
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/resend/resend-go/v3"
+)
+
+// sequence is an endpoint response not modeled by the SDK
+type sequence struct {
+	Id     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+func withRawRequestsExample() {
+	ctx := context.TODO()
+	apiKey := os.Getenv("RESEND_API_KEY")
+
+	client := resend.NewClient(apiKey)
+
+	// Call the endpoint with the client's auth, retries and middleware
+	created, err := resend.Do[sequence](ctx, client, http.MethodPost, "sequences",
+		map[string]any{"name": "Onboarding"},
+		resend.WithIdempotencyKey("create-onboarding-sequence"))
+	if errors.Is(err, resend.ErrValidation) {
+		fmt.Println("Invalid sequence:", err)
+		return
+	}
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(created.Id)
+
+	// Walk every page of a {data, has_more} list
+	limit := 50
+	sequences := resend.List(ctx, client, "sequences", &resend.ListOptions{Limit: &limit},
+		func(s sequence) string { return s.Id },
+		resend.WithQuery(url.Values{"status": {"active"}}))
+	for s, err := range sequences {
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(s.Name, s.Status)
+	}
+}
@@ -0,0 +1,38 @@
+package examples
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/resend/resend-go/v3"
+)
+
+func withResponseMetaExample() {
+	apiKey := os.Getenv("RESEND_API_KEY")
+
+	client := resend.NewClient(apiKey)
+
+	// Capture the response metadata of the request made with ctx
+	var meta resend.ResponseMeta
+	ctx := resend.WithResponseMeta(context.TODO(), &meta)
+
+	params := &resend.SendEmailRequest{
+		To:      []string{"delivered@resend.dev"},
+		From:    "onboarding@resend.dev",
+		Text:    "hello world",
+		Subject: "Hello from Golang",
+	}
+
+	sent, err := client.Emails.SendWithContext(ctx, params)
+	fmt.Printf("Status: %d, request id: %s\n", meta.StatusCode, meta.RequestId)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(sent.Id)
+
+	if meta.RateLimit.Present {
+		fmt.Printf("Rate limit: %d/%d remaining, resets in %s\n",
+			meta.RateLimit.Remaining, meta.RateLimit.Limit, meta.RateLimit.Reset)
+	}
+}
@@ -0,0 +1,39 @@
+package examples
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/resend/resend-go/v3"
+)
+
+func withRetriesExample() {
+	ctx := context.TODO()
+	apiKey := os.Getenv("RESEND_API_KEY")
+
+	client := resend.NewClient(apiKey)
+
+	// Retry 429 and 5xx responses as well as network errors with exponential backoff.
+	// The retry-after and ratelimit-reset headers are honored automatically.
+	client.RetryPolicy = resend.DefaultRetryPolicy()
+
+	params := &resend.SendEmailRequest{
+		To:      []string{"delivered@resend.dev"},
+		From:    "onboarding@resend.dev",
+		Text:    "hello world",
+		Subject: "Hello from Golang",
+	}
+
+	// POST requests are only retried when an idempotency key is set,
+	// so the email is never delivered twice.
+	options := &resend.SendEmailOptions{
+		IdempotencyKey: "welcome-email/user-123",
+	}
+
+	sent, err := client.Emails.SendWithOptions(ctx, params, options)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Email sent successfully: %s\n", sent.Id)
+}
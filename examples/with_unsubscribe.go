@@ -0,0 +1,52 @@
+package examples
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/resend/resend-go/v3"
+)
+
+func withUnsubscribeExample() {
+	apiKey := os.Getenv("RESEND_API_KEY")
+
+	client := resend.NewClient(apiKey)
+
+	// The secret must stay the same, or the links of the emails already
+	// sent stop working
+	unsub, err := resend.NewUnsubscriber(client, &resend.UnsubscribeOptions{
+		Secret:  os.Getenv("UNSUBSCRIBE_SECRET"),
+		BaseURL: "https://example.com/unsubscribe",
+		OnError: func(r *http.Request, err error) {
+			fmt.Println("Failed to unsubscribe:", err)
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// Adds List-Unsubscribe and List-Unsubscribe-Post to the headers,
+	// unsubscribing the contact from the topic in one click
+	params := &resend.SendEmailRequest{
+		From:    "onboarding@resend.dev",
+		To:      []string{"delivered@resend.dev"},
+		Subject: "Our weekly news",
+		Html:    "<p>This week at Acme</p>",
+	}
+	if err := unsub.AddHeaders(params, "e169aa45-1ecf-4183-9955-b1499d5701d3", "b6d24b8e-af0b-4c3c-be0c-359bbd97381e"); err != nil {
+		panic(err)
+	}
+
+	sent, err := client.Emails.Send(params)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(sent.Id)
+
+	// Serve the unsubscribe links
+	http.Handle("/unsubscribe", unsub)
+	if err := http.ListenAndServe(":8080", nil); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,211 @@
+package resend
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// With Client.PreserveUnknownFields set, response types keep the JSON
+// fields they do not declare, returned by their Extra method, so data added
+// to the API is readable before the SDK models it:
+//
+//	client := resend.NewClient(apiKey, resend.WithUnknownFields())
+//	email, err := client.Emails.Get(id)
+//	var region string
+//	err = json.Unmarshal(email.Extra()["region"], &region)
+//
+// Extra is nil when every field of the response is known. Nested objects
+// are handled the same way when their type has an Extra method, and so are
+// the types decoded with Do and List.
+
+// UnknownFieldsError is returned in strict decoding mode, see
+// Client.StrictDecoding, when a response has fields the SDK does not model.
+// Fields holds their paths, like "data[0].region".
+type UnknownFieldsError struct {
+	Fields []string
+}
+
+// Error returns the unknown fields of the response
+func (e *UnknownFieldsError) Error() string {
+	return "[ERROR]: Unknown fields in response: " + strings.Join(e.Fields, ", ")
+}
+
+// unknownFields is embedded in the response types to hold their unknown
+// fields. The fields are kept behind a pointer so the response types stay
+// comparable; two responses decoded with unknown fields are never equal.
+type unknownFields struct {
+	extra *map[string]json.RawMessage
+}
+
+// Extra returns the JSON fields of the response its type does not declare,
+// keyed by name. It is nil unless Client.PreserveUnknownFields is set and
+// the response has unknown fields.
+func (u unknownFields) Extra() map[string]json.RawMessage {
+	if u.extra == nil {
+		return nil
+	}
+	return *u.extra
+}
+
+func (u *unknownFields) setExtra(fields map[string]json.RawMessage) {
+	u.extra = &fields
+}
+
+// extraSetter is implemented by the pointers to the types embedding
+// unknownFields
+type extraSetter interface {
+	setExtra(fields map[string]json.RawMessage)
+}
+
+// fieldAliaser is implemented by types whose UnmarshalJSON accepts JSON
+// fields besides the ones of their struct tags
+type fieldAliaser interface {
+	jsonAliases() []string
+}
+
+var (
+	fieldAliaserType = reflect.TypeOf((*fieldAliaser)(nil)).Elem()
+	extraSetterType  = reflect.TypeOf((*extraSetter)(nil)).Elem()
+)
+
+// decodeExtra walks v, already decoded from data, and stores the fields of
+// every JSON object that its struct does not declare into the struct's
+// unknownFields. It returns the paths of the unknown fields.
+func decodeExtra(data []byte, v any) []string {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return nil
+	}
+	var unknown []string
+	walkExtra(data, rv.Elem(), "", &unknown)
+	return unknown
+}
+
+func walkExtra(data []byte, v reflect.Value, path string, unknown *[]string) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			walkExtra(data, v.Elem(), path, unknown)
+		}
+
+	case reflect.Slice, reflect.Array:
+		var items []json.RawMessage
+		if json.Unmarshal(data, &items) != nil {
+			return
+		}
+		for i := 0; i < len(items) && i < v.Len(); i++ {
+			walkExtra(items[i], v.Index(i), path+"["+strconv.Itoa(i)+"]", unknown)
+		}
+
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String || !walkable(v.Type().Elem()) {
+			return
+		}
+		var fields map[string]json.RawMessage
+		if json.Unmarshal(data, &fields) != nil {
+			return
+		}
+		for key, raw := range fields {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			if value := v.MapIndex(reflect.ValueOf(key).Convert(v.Type().Key())); value.IsValid() {
+				elem.Set(value)
+			}
+			before := len(*unknown)
+			walkExtra(raw, elem, joinPath(path, key), unknown)
+			if elem.Kind() == reflect.Struct && len(*unknown) > before {
+				v.SetMapIndex(reflect.ValueOf(key).Convert(v.Type().Key()), elem)
+			}
+		}
+
+	case reflect.Struct:
+		var fields map[string]json.RawMessage
+		if json.Unmarshal(data, &fields) != nil {
+			return
+		}
+		known := make(map[string]bool)
+		walkStruct(fields, v, path, known, unknown)
+		if v.CanAddr() && v.Addr().Type().Implements(fieldAliaserType) {
+			for _, alias := range v.Addr().Interface().(fieldAliaser).jsonAliases() {
+				known[alias] = true
+			}
+		}
+
+		extra := make(map[string]json.RawMessage)
+		for key, raw := range fields {
+			if !known[key] {
+				extra[key] = raw
+				*unknown = append(*unknown, joinPath(path, key))
+			}
+		}
+		if len(extra) > 0 && v.CanAddr() && v.Addr().Type().Implements(extraSetterType) {
+			v.Addr().Interface().(extraSetter).setExtra(extra)
+		}
+	}
+}
+
+// walkStruct walks the fields of v present in the JSON object, including
+// those of embedded structs, and marks them as known
+func walkStruct(fields map[string]json.RawMessage, v reflect.Value, path string, known map[string]bool, unknown *[]string) {
+	t := v.Type()
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if sf.Anonymous && name == "" {
+			embedded := v.Field(i)
+			if embedded.Kind() == reflect.Pointer {
+				if embedded.IsNil() {
+					continue
+				}
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				walkStruct(fields, embedded, path, known, unknown)
+				continue
+			}
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+
+		// Keys match field names case-insensitively, like encoding/json does
+		key, ok := name, false
+		if _, ok = fields[name]; !ok {
+			for k := range fields {
+				if strings.EqualFold(k, name) && !known[k] {
+					key, ok = k, true
+					break
+				}
+			}
+		}
+		if !ok {
+			continue
+		}
+		known[key] = true
+		if walkable(sf.Type) {
+			walkExtra(fields[key], v.Field(i), joinPath(path, key), unknown)
+		}
+	}
+}
+
+// walkable reports whether values of t can hold JSON objects to walk
+func walkable(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
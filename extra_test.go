@@ -0,0 +1,105 @@
+package resend
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponseExtraFields(t *testing.T) {
+	setup()
+	defer teardown()
+	client.PreserveUnknownFields = true
+
+	mux.HandleFunc("/emails/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"1","object":"email","Subject":"Hi","region":"eu-west-1","tracking":{"opens":2}}`))
+	})
+	mux.HandleFunc("/emails", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"object":"list","has_more":false,"data":[{"id":"1"},{"id":"2","region":"us-east-1"}],"total":2}`))
+	})
+
+	email, err := client.Emails.Get("1")
+	assert.NoError(t, err)
+	assert.Equal(t, "Hi", email.Subject)
+	assert.Equal(t, map[string]json.RawMessage{
+		"region":   json.RawMessage(`"eu-west-1"`),
+		"tracking": json.RawMessage(`{"opens":2}`),
+	}, email.Extra())
+
+	emails, err := client.Emails.List()
+	assert.NoError(t, err)
+	assert.Equal(t, json.RawMessage(`2`), emails.Extra()["total"])
+	assert.Nil(t, emails.Data[0].Extra())
+	assert.Equal(t, json.RawMessage(`"us-east-1"`), emails.Data[1].Extra()["region"])
+}
+
+func TestResponseExtraFieldsOptIn(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/emails", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"1","region":"eu-west-1"}`))
+	})
+
+	params := &SendEmailRequest{From: "onboarding@resend.dev", To: []string{"jane@example.com"}, Subject: "Hi", Html: "<p>Hi</p>"}
+	first, err := client.Emails.Send(params)
+	assert.NoError(t, err)
+	assert.Nil(t, first.Extra())
+
+	// Responses stay comparable
+	second, err := client.Emails.Send(params)
+	assert.NoError(t, err)
+	assert.True(t, *first == *second)
+
+	client.PreserveUnknownFields = true
+	preserved, err := client.Emails.Send(params)
+	assert.NoError(t, err)
+	assert.Equal(t, json.RawMessage(`"eu-west-1"`), preserved.Extra()["region"])
+}
+
+func TestStrictDecoding(t *testing.T) {
+	setup()
+	defer teardown()
+	client.StrictDecoding = true
+
+	mux.HandleFunc("/emails", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"object":"list","has_more":false,"data":[{"id":"1","region":"eu-west-1"}],"total":1}`))
+	})
+	mux.HandleFunc("/domains/d_1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"d_1","name":"example.com","records":[{"record":"SPF","ttl":"Auto","weight":10}]}`))
+	})
+	mux.HandleFunc("/domains", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"d_1","name":"example.com","created_at":"2024-08-05 11:52:01.858+00","records":[]}`))
+	})
+
+	_, err := client.Emails.List()
+	var unknownErr *UnknownFieldsError
+	assert.True(t, errors.As(err, &unknownErr))
+	assert.Equal(t, []string{"data[0].region", "total"}, unknownErr.Fields)
+
+	_, err = client.Domains.Get("d_1")
+	assert.True(t, errors.As(err, &unknownErr))
+	assert.Equal(t, []string{"records[0].weight"}, unknownErr.Fields)
+	assert.Equal(t, "[ERROR]: Unknown fields in response: records[0].weight", err.Error())
+
+	domain, err := client.Domains.Create(&CreateDomainRequest{Name: "example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-08-05 11:52:01.858+00", domain.CreatedAt)
+	assert.Nil(t, domain.Extra())
+}
+
+func TestStrictDecodingOption(t *testing.T) {
+	c, err := NewClientWithOptions("re_123", WithStrictDecoding(), WithUnknownFields())
+	assert.NoError(t, err)
+	assert.True(t, c.StrictDecoding)
+	assert.True(t, c.PreserveUnknownFields)
+}
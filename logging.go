@@ -0,0 +1,247 @@
+package resend
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// redacted replaces sensitive values in log records
+const redacted = "[REDACTED]"
+
+// maxLoggedBodySize is the size above which request bodies are not logged,
+// ie: when they stream large attachments
+const maxLoggedBodySize = 1 << 20
+
+// emailAddressRe matches the local part and domain of email addresses
+var emailAddressRe = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@([A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)+)`)
+
+// LogOptions controls what the client logs for every request.
+//
+// The zero value logs request metadata only (method, path, status, latency,
+// request id and retry attempt) and redacts every sensitive value.
+type LogOptions struct {
+	// Level is the level of records for successful requests, debug level
+	// when nil. Failed requests are always logged at warn level.
+	Level slog.Leveler
+
+	// LogHeaders adds the request headers to the records
+	LogHeaders bool
+
+	// LogBodies adds the JSON request and response bodies to the records
+	LogBodies bool
+
+	// RevealAPIKey disables the redaction of the Authorization header
+	RevealAPIKey bool
+
+	// RevealEmailAddresses disables the masking of email addresses,
+	// ie: in SendEmailRequest recipients or Contact emails
+	RevealEmailAddresses bool
+
+	// RevealAttachmentContent disables the redaction of attachment content
+	RevealAttachmentContent bool
+
+	// RevealSigningSecrets disables the redaction of webhook signing secrets
+	RevealSigningSecrets bool
+}
+
+// LoggingMiddleware returns a Middleware logging every request with its
+// method, path, status, latency and attempt number. Successful requests are
+// logged at debug level and failed ones at warn level. A nil logger disables
+// logging.
+func LoggingMiddleware(logger *slog.Logger) Middleware {
+	return LoggingMiddlewareWithOptions(logger, nil)
+}
+
+// LoggingMiddlewareWithOptions returns a Middleware logging every request
+// like LoggingMiddleware, with its request id, honoring the given options.
+// A nil options logs request metadata only and redacts every sensitive value.
+func LoggingMiddlewareWithOptions(logger *slog.Logger, options *LogOptions) Middleware {
+	return func(next Handler) Handler {
+		if logger == nil {
+			return next
+		}
+		if options == nil {
+			options = &LogOptions{}
+		}
+		return func(req *http.Request, ret any) (*http.Response, error) {
+			ctx := req.Context()
+			start := time.Now()
+
+			// Read the body before it is consumed by the request
+			var reqBody string
+			if options.LogBodies {
+				reqBody = options.requestBody(req)
+			}
+
+			resp, err := next(req, ret)
+
+			attrs := []slog.Attr{
+				slog.String("method", req.Method),
+				slog.String("path", options.redactString(req.URL.Path)),
+				slog.Int("attempt", RetryAttempt(ctx)),
+				slog.Duration("latency", time.Since(start)),
+			}
+
+			var status int
+			var requestId string
+			if resp != nil {
+				status = resp.StatusCode
+				requestId = resp.Header.Get(requestIdHeader)
+			} else {
+				status = errorStatusCode(err)
+				var apiErr *APIError
+				if errors.As(err, &apiErr) {
+					requestId = apiErr.RequestId
+				}
+			}
+			if status != 0 {
+				attrs = append(attrs, slog.Int("status", status))
+			}
+			if requestId != "" {
+				attrs = append(attrs, slog.String("request_id", requestId))
+			}
+
+			if options.LogHeaders {
+				attrs = append(attrs, slog.Any("headers", options.redactHeaders(req.Header)))
+			}
+			if reqBody != "" {
+				attrs = append(attrs, slog.String("request_body", reqBody))
+			}
+
+			if err != nil {
+				attrs = append(attrs, slog.String("error", options.redactString(err.Error())))
+				logger.LogAttrs(ctx, slog.LevelWarn, "resend: request failed", attrs...)
+				return resp, err
+			}
+
+			if options.LogBodies {
+				if body := options.responseBody(ret); body != "" {
+					attrs = append(attrs, slog.String("response_body", body))
+				}
+			}
+			level := slog.LevelDebug
+			if options.Level != nil {
+				level = options.Level.Level()
+			}
+			logger.LogAttrs(ctx, level, "resend: request", attrs...)
+			return resp, err
+		}
+	}
+}
+
+// requestBody returns the redacted JSON body of the request, if it can be
+// read without consuming it.
+func (o *LogOptions) requestBody(req *http.Request) string {
+	if req.GetBody == nil || !strings.HasPrefix(req.Header.Get("Content-Type"), contentType) {
+		return ""
+	}
+	if req.ContentLength < 0 || req.ContentLength > maxLoggedBodySize {
+		return ""
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return ""
+	}
+	return o.redactJSON(data)
+}
+
+// responseBody returns the redacted JSON encoding of the decoded response.
+func (o *LogOptions) responseBody(ret any) string {
+	if ret == nil {
+		return ""
+	}
+	if _, ok := ret.(io.Writer); ok {
+		return ""
+	}
+
+	data, err := json.Marshal(ret)
+	if err != nil {
+		return ""
+	}
+	return o.redactJSON(data)
+}
+
+// redactJSON redacts the sensitive values of a JSON document
+func (o *LogOptions) redactJSON(data []byte) string {
+	var v any
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return ""
+	}
+
+	out, err := json.Marshal(o.redactValue(v))
+	if err != nil {
+		return ""
+	}
+	return string(out)
+}
+
+// redactValue walks a decoded JSON value and redacts its sensitive fields
+func (o *LogOptions) redactValue(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, val := range t {
+			switch {
+			case k == "signing_secret" && !o.RevealSigningSecrets:
+				t[k] = redacted
+			case k == "attachments" && !o.RevealAttachmentContent:
+				if attachments, ok := val.([]any); ok {
+					for _, a := range attachments {
+						if m, ok := a.(map[string]any); ok {
+							if _, ok := m["content"]; ok {
+								m["content"] = redacted
+							}
+						}
+					}
+				}
+				t[k] = o.redactValue(val)
+			default:
+				t[k] = o.redactValue(val)
+			}
+		}
+		return t
+	case []any:
+		for i, val := range t {
+			t[i] = o.redactValue(val)
+		}
+		return t
+	case string:
+		return o.redactString(t)
+	}
+	return v
+}
+
+// redactString masks the local part of the email addresses in s
+func (o *LogOptions) redactString(s string) string {
+	if o.RevealEmailAddresses {
+		return s
+	}
+	return emailAddressRe.ReplaceAllString(s, "***@$1")
+}
+
+// redactHeaders returns a copy of the headers with the API key redacted
+func (o *LogOptions) redactHeaders(header http.Header) map[string]string {
+	out := make(map[string]string, len(header))
+	for k, v := range header {
+		out[k] = strings.Join(v, ", ")
+	}
+	if _, ok := out["Authorization"]; ok && !o.RevealAPIKey {
+		out["Authorization"] = "Bearer " + redacted
+	}
+	return out
+}
@@ -0,0 +1,158 @@
+package resend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// logRecords decodes the JSON log records written to buf
+func logRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var r map[string]any
+		assert.NoError(t, json.Unmarshal([]byte(line), &r))
+		records = append(records, r)
+	}
+	return records
+}
+
+func TestLoggingMiddlewareWithOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/emails/123", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req_"+string(rune('0'+attempts)))
+		if attempts == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"message":"Server error"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(&Email{Id: "123"})
+	})
+
+	buf := &bytes.Buffer{}
+	client.Logger = slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client.RetryPolicy = testRetryPolicy()
+	client.LogOptions = LogOptions{Level: slog.LevelInfo}
+
+	_, err := client.Emails.Get("123")
+	assert.NoError(t, err)
+
+	records := logRecords(t, buf)
+	assert.Len(t, records, 2)
+	failed, succeeded := records[0], records[1]
+
+	assert.Equal(t, "WARN", failed["level"])
+	assert.Equal(t, "GET", failed["method"])
+	assert.Equal(t, "/emails/123", failed["path"])
+	assert.Equal(t, float64(500), failed["status"])
+	assert.Equal(t, float64(1), failed["attempt"])
+	assert.Equal(t, "req_1", failed["request_id"])
+	assert.Equal(t, "[ERROR]: Server error", failed["error"])
+
+	assert.Equal(t, "INFO", succeeded["level"])
+	assert.Equal(t, float64(200), succeeded["status"])
+	assert.Equal(t, float64(2), succeeded["attempt"])
+	assert.Equal(t, "req_2", succeeded["request_id"])
+	assert.Contains(t, succeeded, "latency")
+	assert.NotContains(t, succeeded, "headers")
+	assert.NotContains(t, succeeded, "request_body")
+}
+
+func TestLoggingMiddlewareRedactsSensitiveValues(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/emails", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&SendEmailResponse{Id: "1"})
+	})
+	mux.HandleFunc("/webhooks/wh_1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&Webhook{Id: "wh_1", SigningSecret: "whsec_secret"})
+	})
+
+	buf := &bytes.Buffer{}
+	client.ApiKey = "re_secret"
+	client.Logger = slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client.LogOptions = LogOptions{LogHeaders: true, LogBodies: true}
+
+	_, err := client.Emails.Send(&SendEmailRequest{
+		From:        "Acme <onboarding@acme.com>",
+		To:          []string{"jane.doe@example.com"},
+		Subject:     "Invoice",
+		Attachments: []*Attachment{{Filename: "invoice.pdf", Content: []byte("%PDF-secret")}},
+	})
+	assert.NoError(t, err)
+
+	_, err = client.Webhooks.Get("wh_1")
+	assert.NoError(t, err)
+
+	out := buf.String()
+	assert.NotContains(t, out, "re_secret")
+	assert.NotContains(t, out, "jane.doe")
+	assert.NotContains(t, out, "onboarding@")
+	assert.NotContains(t, out, "whsec_secret")
+
+	records := logRecords(t, buf)
+	assert.Len(t, records, 2)
+
+	headers := records[0]["headers"].(map[string]any)
+	assert.Equal(t, "Bearer [REDACTED]", headers["Authorization"])
+
+	var body map[string]any
+	assert.NoError(t, json.Unmarshal([]byte(records[0]["request_body"].(string)), &body))
+	assert.Equal(t, "Acme <***@acme.com>", body["from"])
+	assert.Equal(t, []any{"***@example.com"}, body["to"])
+	assert.Equal(t, "Invoice", body["subject"])
+	attachment := body["attachments"].([]any)[0].(map[string]any)
+	assert.Equal(t, "[REDACTED]", attachment["content"])
+	assert.Equal(t, "invoice.pdf", attachment["filename"])
+
+	assert.Contains(t, records[1]["response_body"], `"signing_secret":"[REDACTED]"`)
+}
+
+func TestLoggingMiddlewareRevealOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/contacts/jane@example.com", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&Contact{Id: "1", Email: "jane@example.com"})
+	})
+
+	buf := &bytes.Buffer{}
+	client.ApiKey = "re_secret"
+	client.Logger = slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client.LogOptions = LogOptions{
+		LogHeaders:           true,
+		LogBodies:            true,
+		RevealAPIKey:         true,
+		RevealEmailAddresses: true,
+	}
+
+	_, err := client.Contacts.GetWithContext(context.Background(), &GetContactOptions{Id: "jane@example.com"})
+	assert.NoError(t, err)
+
+	records := logRecords(t, buf)
+	assert.Len(t, records, 1)
+	assert.Equal(t, "/contacts/jane@example.com", records[0]["path"])
+	assert.Equal(t, "Bearer re_secret", records[0]["headers"].(map[string]any)["Authorization"])
+	assert.Contains(t, records[0]["response_body"], `"email":"jane@example.com"`)
+}
+
+func TestLoggingMiddlewareMasksEmailsInPath(t *testing.T) {
+	o := &LogOptions{}
+	assert.Equal(t, "/contacts/***@example.com", o.redactString("/contacts/jane@example.com"))
+	assert.Equal(t, "no address here", o.redactString("no address here"))
+}
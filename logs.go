@@ -2,6 +2,7 @@ package resend
 
 import (
 	"context"
+	"iter"
 	"net/http"
 )
 
@@ -15,12 +16,16 @@ type Log struct {
 	UserAgent      *string `json:"user_agent"`
 	RequestBody    any     `json:"request_body,omitempty"`
 	ResponseBody   any     `json:"response_body,omitempty"`
+
+	unknownFields
 }
 
 type ListLogsResponse struct {
 	Object  string `json:"object"`
 	Data    []Log  `json:"data"`
 	HasMore bool   `json:"has_more"`
+
+	unknownFields
 }
 
 type LogsSvc interface {
@@ -29,6 +34,7 @@ type LogsSvc interface {
 	ListWithOptions(ctx context.Context, options *ListOptions) (ListLogsResponse, error)
 	ListWithContext(ctx context.Context) (ListLogsResponse, error)
 	List() (ListLogsResponse, error)
+	All(ctx context.Context, options *ListOptions) iter.Seq2[Log, error]
 }
 
 type LogsSvcImpl struct {
@@ -90,3 +96,13 @@ func (s *LogsSvcImpl) ListWithContext(ctx context.Context) (ListLogsResponse, er
 func (s *LogsSvcImpl) List() (ListLogsResponse, error) {
 	return s.ListWithContext(context.Background())
 }
+
+// All iterates over all logs, fetching pages lazily
+// https://resend.com/docs/api-reference/logs/list-logs
+func (s *LogsSvcImpl) All(ctx context.Context, options *ListOptions) iter.Seq2[Log, error] {
+	return paginate(ctx, listCursor(options), func(item Log) string { return item.Id },
+		func(ctx context.Context, cursor ListOptions) ([]Log, bool, error) {
+			resp, err := s.ListWithOptions(ctx, &cursor)
+			return resp.Data, resp.HasMore, err
+		})
+}
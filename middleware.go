@@ -0,0 +1,78 @@
+package resend
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Handler sends a request to the Resend API and decodes the response into ret.
+// On failure the error is the one returned to the caller, ie: *APIError,
+// *RateLimitError or a transport error.
+type Handler func(req *http.Request, ret any) (*http.Response, error)
+
+// Middleware wraps a Handler to run code around every request sent by the client,
+// whether it was built with NewRequest, NewRequestWithOptions or NewMultipartRequest.
+type Middleware func(next Handler) Handler
+
+// Use registers middlewares wrapping every request sent by the client.
+// Middlewares run in the order they are registered, the first one being the
+// outermost. They wrap the built-in retry, logging and rate limiting
+// middlewares configured on the client, so they run once per call rather
+// than once per attempt.
+//
+// Use is not safe to call concurrently with requests.
+func (c *Client) Use(middlewares ...Middleware) {
+	c.middlewares = append(c.middlewares, middlewares...)
+}
+
+// handler builds the middleware chain:
+// user middlewares -> retry -> logging -> rate limit -> send
+func (c *Client) handler() Handler {
+	h := Handler(c.send)
+	h = RateLimitMiddleware(c.RateLimiter)(h)
+	h = LoggingMiddlewareWithOptions(c.Logger, &c.LogOptions)(h)
+	h = RetryMiddleware(c.RetryPolicy)(h)
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		h = c.middlewares[i](h)
+	}
+	return h
+}
+
+// errorStatusCode returns the HTTP status code carried by an error returned
+// by the API, or 0 for transport and decoding errors.
+func errorStatusCode(err error) int {
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return http.StatusTooManyRequests
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// errorHeader returns the response headers carried by an error returned
+// by the API, or nil for transport and decoding errors.
+func errorHeader(err error) http.Header {
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		h := make(http.Header)
+		for k, v := range map[string]string{
+			"ratelimit-limit":     rateLimitErr.Limit,
+			"ratelimit-remaining": rateLimitErr.Remaining,
+			"ratelimit-reset":     rateLimitErr.Reset,
+			"retry-after":         rateLimitErr.RetryAfter,
+		} {
+			if v != "" {
+				h.Set(k, v)
+			}
+		}
+		return h
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Header
+	}
+	return nil
+}
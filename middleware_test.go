@@ -0,0 +1,185 @@
+package resend
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddlewareOrderAndResult(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/emails/123", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "acme", r.Header.Get("X-Tenant"))
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&Email{Id: "123"})
+	})
+
+	var calls []string
+	trace := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(req *http.Request, ret any) (*http.Response, error) {
+				calls = append(calls, name+":before")
+				resp, err := next(req, ret)
+				calls = append(calls, name+":after")
+				return resp, err
+			}
+		}
+	}
+
+	var seen *Email
+	client.Use(trace("first"), trace("second"), func(next Handler) Handler {
+		return func(req *http.Request, ret any) (*http.Response, error) {
+			req.Header.Set("X-Tenant", "acme")
+			resp, err := next(req, ret)
+			seen, _ = ret.(*Email)
+			return resp, err
+		}
+	})
+
+	email, err := client.Emails.Get("123")
+	assert.NoError(t, err)
+	assert.Equal(t, "123", email.Id)
+	assert.Equal(t, []string{"first:before", "second:before", "second:after", "first:after"}, calls)
+	assert.Same(t, email, seen)
+}
+
+func TestMiddlewareSeesTypedError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/emails/123", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"name":"not_found","message":"Email not found"}`))
+	})
+
+	var seen error
+	client.Use(func(next Handler) Handler {
+		return func(req *http.Request, ret any) (*http.Response, error) {
+			resp, err := next(req, ret)
+			seen = err
+			return resp, err
+		}
+	})
+
+	_, err := client.Emails.Get("123")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Same(t, err, seen)
+}
+
+func TestMiddlewareFaultInjectionIsRetried(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/emails/123", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&Email{Id: "123"})
+	})
+
+	// Middlewares composed by hand can sit inside the built-in retry middleware
+	failures := 0
+	client.Use(RetryMiddleware(testRetryPolicy()), func(next Handler) Handler {
+		return func(req *http.Request, ret any) (*http.Response, error) {
+			if failures < 2 {
+				failures++
+				return nil, &APIError{StatusCode: http.StatusServiceUnavailable, Message: "injected"}
+			}
+			return next(req, ret)
+		}
+	})
+
+	email, err := client.Emails.Get("123")
+	assert.NoError(t, err)
+	assert.Equal(t, "123", email.Id)
+	assert.Equal(t, 2, failures)
+}
+
+func TestMiddlewareWrapsMultipartRequests(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/contacts/imports", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"object":"contact_import","id":"1"}`)
+	})
+
+	var contentType string
+	client.Use(func(next Handler) Handler {
+		return func(req *http.Request, ret any) (*http.Response, error) {
+			contentType = req.Header.Get("Content-Type")
+			return next(req, ret)
+		}
+	})
+
+	_, err := client.Contacts.Imports.Create(&CreateContactImportRequest{File: []byte("email\na@b.com")})
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(contentType, "multipart/form-data"))
+}
+
+func TestWithMiddlewareOption(t *testing.T) {
+	stop := errors.New("stop")
+	c := NewClient("re_123", WithMiddleware(func(next Handler) Handler {
+		return func(req *http.Request, ret any) (*http.Response, error) {
+			return nil, stop
+		}
+	}))
+
+	_, err := c.Emails.Get("123")
+	assert.ErrorIs(t, err, stop)
+
+	_, err = NewClientWithOptions("re_123", WithMiddleware(nil))
+	assert.Error(t, err)
+}
+
+func TestLoggingMiddleware(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/emails/123", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Content-Type", "application/json")
+		if attempts == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"message":"Server error"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(&Email{Id: "123"})
+	})
+
+	buf := &bytes.Buffer{}
+	client.Logger = slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client.RetryPolicy = testRetryPolicy()
+
+	_, err := client.Emails.Get("123")
+	assert.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 2)
+
+	var failed, succeeded map[string]any
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &failed))
+	assert.NoError(t, json.Unmarshal([]byte(lines[1]), &succeeded))
+
+	assert.Equal(t, "WARN", failed["level"])
+	assert.Equal(t, "GET", failed["method"])
+	assert.Equal(t, "/emails/123", failed["path"])
+	assert.Equal(t, float64(500), failed["status"])
+	assert.Equal(t, float64(1), failed["attempt"])
+	assert.Equal(t, "[ERROR]: Server error", failed["error"])
+
+	assert.Equal(t, "DEBUG", succeeded["level"])
+	assert.Equal(t, float64(200), succeeded["status"])
+	assert.Equal(t, float64(2), succeeded["attempt"])
+	assert.Contains(t, succeeded, "latency")
+}
@@ -2,6 +2,7 @@ package resend
 
 import (
 	"context"
+	"iter"
 	"net/http"
 )
 
@@ -18,12 +19,16 @@ type OAuthGrant struct {
 	RevokedAt     *string          `json:"revoked_at"`
 	RevokedReason *string          `json:"revoked_reason"`
 	Client        OAuthGrantClient `json:"client"`
+
+	unknownFields
 }
 
 type ListOAuthGrantsResponse struct {
 	Object  string       `json:"object"`
 	Data    []OAuthGrant `json:"data"`
 	HasMore bool         `json:"has_more"`
+
+	unknownFields
 }
 
 type RevokeOAuthGrantResponse struct {
@@ -31,12 +36,15 @@ type RevokeOAuthGrantResponse struct {
 	Id            string  `json:"id"`
 	RevokedAt     *string `json:"revoked_at"`
 	RevokedReason *string `json:"revoked_reason"`
+
+	unknownFields
 }
 
 type OAuthGrantsSvc interface {
 	ListWithOptions(ctx context.Context, options *ListOptions) (ListOAuthGrantsResponse, error)
 	ListWithContext(ctx context.Context) (ListOAuthGrantsResponse, error)
 	List() (ListOAuthGrantsResponse, error)
+	All(ctx context.Context, options *ListOptions) iter.Seq2[OAuthGrant, error]
 	RevokeWithContext(ctx context.Context, oauthGrantId string) (RevokeOAuthGrantResponse, error)
 	Revoke(oauthGrantId string) (RevokeOAuthGrantResponse, error)
 }
@@ -71,6 +79,16 @@ func (s *OAuthGrantsSvcImpl) List() (ListOAuthGrantsResponse, error) {
 	return s.ListWithContext(context.Background())
 }
 
+// All iterates over all OAuth grants, fetching pages lazily
+// https://resend.com/docs/api-reference/oauth-grants/list-oauth-grants
+func (s *OAuthGrantsSvcImpl) All(ctx context.Context, options *ListOptions) iter.Seq2[OAuthGrant, error] {
+	return paginate(ctx, listCursor(options), func(item OAuthGrant) string { return item.Id },
+		func(ctx context.Context, cursor ListOptions) ([]OAuthGrant, bool, error) {
+			resp, err := s.ListWithOptions(ctx, &cursor)
+			return resp.Data, resp.HasMore, err
+		})
+}
+
 func (s *OAuthGrantsSvcImpl) RevokeWithContext(ctx context.Context, oauthGrantId string) (RevokeOAuthGrantResponse, error) {
 	path := "oauth/grants/" + oauthGrantId
 
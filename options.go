@@ -0,0 +1,256 @@
+package resend
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ClientOption configures a Client built with NewClient or NewClientWithOptions.
+type ClientOption func(*clientOptions) error
+
+// clientOptions holds the configuration collected from ClientOptions
+// before the Client is built.
+type clientOptions struct {
+	baseURL         *url.URL
+	httpClient      *http.Client
+	timeout         time.Duration
+	headers         map[string]string
+	userAgentSuffix []string
+	retryPolicy     *RetryPolicy
+	rateLimiter     *RateLimiter
+	logger          *slog.Logger
+	logOptions      LogOptions
+	middlewares     []Middleware
+	validate        bool
+	strict          bool
+	preserve        bool
+}
+
+// reservedHeaders are always set by the client and cannot be overridden with WithHeader
+var reservedHeaders = []string{"Authorization", "User-Agent", "Content-Type", "Accept"}
+
+// NewClientWithOptions builds a new Resend API client configured with the given options.
+// It returns an error if any of the options is invalid.
+func NewClientWithOptions(apiKey string, opts ...ClientOption) (*Client, error) {
+	o, errs := collectOptions(opts)
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	return o.build(apiKey), nil
+}
+
+// collectOptions applies the options, skipping and returning the errors of
+// the invalid ones. Options only modify clientOptions once validated.
+func collectOptions(opts []ClientOption) (*clientOptions, []error) {
+	o := &clientOptions{
+		httpClient: defaultHTTPClient,
+		headers:    make(map[string]string),
+	}
+	var errs []error
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		if err := opt(o); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return o, errs
+}
+
+// build returns a Client configured with the options
+func (o *clientOptions) build(apiKey string) *Client {
+	httpClient := o.httpClient
+	if o.timeout > 0 {
+		// Copy the client so the shared default client is left untouched
+		hc := *httpClient
+		hc.Timeout = o.timeout
+		httpClient = &hc
+	}
+
+	key := strings.Trim(strings.TrimSpace(apiKey), "'")
+	c := NewCustomClient(httpClient, key)
+
+	if o.baseURL != nil {
+		c.BaseURL = o.baseURL
+	}
+	for k, v := range o.headers {
+		c.headers[k] = v
+	}
+	if len(o.userAgentSuffix) > 0 {
+		c.UserAgent += " " + strings.Join(o.userAgentSuffix, " ")
+	}
+	c.RetryPolicy = o.retryPolicy
+	c.RateLimiter = o.rateLimiter
+	c.Logger = o.logger
+	c.LogOptions = o.logOptions
+	c.ValidateRequests = o.validate
+	c.PreserveUnknownFields = o.preserve
+	c.StrictDecoding = o.strict
+	c.Use(o.middlewares...)
+
+	return c
+}
+
+// WithBaseURL sets the base URL of the Resend API, overriding the
+// RESEND_BASE_URL environment variable.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(o *clientOptions) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return fmt.Errorf("[ERROR]: invalid base URL %q: %w", baseURL, err)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("[ERROR]: invalid base URL %q: must be an absolute http(s) URL", baseURL)
+		}
+
+		// Paths are resolved relative to the base URL, which requires a trailing slash
+		if !strings.HasSuffix(u.Path, "/") {
+			u.Path += "/"
+		}
+		o.baseURL = u
+		return nil
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to send requests.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(o *clientOptions) error {
+		if httpClient == nil {
+			return errors.New("[ERROR]: HTTP client must not be nil")
+		}
+		o.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithHeader adds a header sent with every request.
+// The Authorization, User-Agent, Content-Type and Accept headers are reserved.
+func WithHeader(key, value string) ClientOption {
+	return func(o *clientOptions) error {
+		if key == "" || strings.ContainsAny(key, " \t\r\n:") {
+			return fmt.Errorf("[ERROR]: invalid header name %q", key)
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return fmt.Errorf("[ERROR]: invalid value for header %q", key)
+		}
+		for _, h := range reservedHeaders {
+			if strings.EqualFold(h, key) {
+				return fmt.Errorf("[ERROR]: header %q is reserved", key)
+			}
+		}
+		o.headers[http.CanonicalHeaderKey(key)] = value
+		return nil
+	}
+}
+
+// WithUserAgentSuffix appends a suffix to the User-Agent header,
+// ie: "resend-go/3.12.0 my-app/1.0".
+func WithUserAgentSuffix(suffix string) ClientOption {
+	return func(o *clientOptions) error {
+		suffix = strings.TrimSpace(suffix)
+		if suffix == "" || strings.ContainsAny(suffix, "\r\n") {
+			return fmt.Errorf("[ERROR]: invalid User-Agent suffix %q", suffix)
+		}
+		o.userAgentSuffix = append(o.userAgentSuffix, suffix)
+		return nil
+	}
+}
+
+// WithTimeout sets the timeout of each HTTP request. It applies to the HTTP
+// client set with WithHTTPClient, without modifying it.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(o *clientOptions) error {
+		if timeout <= 0 {
+			return fmt.Errorf("[ERROR]: timeout must be positive, got %s", timeout)
+		}
+		o.timeout = timeout
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests.
+// A nil policy disables retries.
+func WithRetryPolicy(policy *RetryPolicy) ClientOption {
+	return func(o *clientOptions) error {
+		if policy != nil {
+			if err := policy.validate(); err != nil {
+				return err
+			}
+		}
+		o.retryPolicy = policy
+		return nil
+	}
+}
+
+// WithRateLimiter sets the rate limiter shared by every service of the client.
+func WithRateLimiter(limiter *RateLimiter) ClientOption {
+	return func(o *clientOptions) error {
+		o.rateLimiter = limiter
+		return nil
+	}
+}
+
+// WithLogger sets the logger used for diagnostic messages.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(o *clientOptions) error {
+		if logger == nil {
+			return errors.New("[ERROR]: logger must not be nil")
+		}
+		o.logger = logger
+		return nil
+	}
+}
+
+// WithLogOptions controls what is logged and redacted by the logger set with WithLogger.
+func WithLogOptions(options LogOptions) ClientOption {
+	return func(o *clientOptions) error {
+		o.logOptions = options
+		return nil
+	}
+}
+
+// WithRequestValidation validates emails on the client side before they
+// are sent, see Client.ValidateRequests.
+func WithRequestValidation() ClientOption {
+	return func(o *clientOptions) error {
+		o.validate = true
+		return nil
+	}
+}
+
+// WithUnknownFields keeps the response fields the SDK does not model, see
+// Client.PreserveUnknownFields.
+func WithUnknownFields() ClientOption {
+	return func(o *clientOptions) error {
+		o.preserve = true
+		return nil
+	}
+}
+
+// WithStrictDecoding fails responses having fields the SDK does not model,
+// see Client.StrictDecoding.
+func WithStrictDecoding() ClientOption {
+	return func(o *clientOptions) error {
+		o.strict = true
+		return nil
+	}
+}
+
+// WithMiddleware registers middlewares wrapping every request, see Client.Use.
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return func(o *clientOptions) error {
+		for _, m := range middlewares {
+			if m == nil {
+				return errors.New("[ERROR]: middleware must not be nil")
+			}
+		}
+		o.middlewares = append(o.middlewares, middlewares...)
+		return nil
+	}
+}
@@ -0,0 +1,142 @@
+package resend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClientWithOptions(t *testing.T) {
+	httpClient := &http.Client{Timeout: time.Second}
+	policy := DefaultRetryPolicy()
+	limiter := NewRateLimiter(2, 2)
+	logger := slog.New(slog.NewTextHandler(&bytes.Buffer{}, nil))
+
+	c, err := NewClientWithOptions(" 're_123' ",
+		WithBaseURL("https://eu.example.com/v1"),
+		WithHTTPClient(httpClient),
+		WithHeader("x-tenant", "acme"),
+		WithUserAgentSuffix("my-app/1.0"),
+		WithRetryPolicy(policy),
+		WithRateLimiter(limiter),
+		WithLogger(logger),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "re_123", c.ApiKey)
+	assert.Equal(t, "https://eu.example.com/v1/", c.BaseURL.String())
+	assert.Same(t, httpClient, c.client)
+	assert.Equal(t, userAgent+" my-app/1.0", c.UserAgent)
+	assert.Same(t, policy, c.RetryPolicy)
+	assert.Same(t, limiter, c.RateLimiter)
+	assert.Same(t, logger, c.Logger)
+
+	req, err := c.NewRequest(context.Background(), http.MethodGet, "emails", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://eu.example.com/v1/emails", req.URL.String())
+	assert.Equal(t, "acme", req.Header.Get("X-Tenant"))
+	assert.Equal(t, userAgent+" my-app/1.0", req.Header.Get("User-Agent"))
+}
+
+func TestNewClientWithTimeoutDoesNotModifyHTTPClient(t *testing.T) {
+	httpClient := &http.Client{Timeout: time.Minute}
+
+	// The timeout applies regardless of the order of the options
+	c, err := NewClientWithOptions("re_123", WithTimeout(5*time.Second), WithHTTPClient(httpClient))
+	assert.NoError(t, err)
+	assert.Equal(t, 5*time.Second, c.client.Timeout)
+	assert.Equal(t, time.Minute, httpClient.Timeout)
+
+	c, err = NewClientWithOptions("re_123", WithTimeout(5*time.Second))
+	assert.NoError(t, err)
+	assert.Equal(t, 5*time.Second, c.client.Timeout)
+	assert.Equal(t, time.Minute, defaultHTTPClient.Timeout)
+}
+
+func TestNewClientWithInvalidOptions(t *testing.T) {
+	cases := []struct {
+		desc string
+		opt  ClientOption
+	}{
+		{"relative_base_url", WithBaseURL("/emails")},
+		{"unsupported_scheme", WithBaseURL("ftp://example.com")},
+		{"nil_http_client", WithHTTPClient(nil)},
+		{"empty_header_name", WithHeader("", "value")},
+		{"invalid_header_name", WithHeader("x tenant", "value")},
+		{"header_value_with_newline", WithHeader("x-tenant", "a\r\nb")},
+		{"reserved_header", WithHeader("authorization", "Bearer other")},
+		{"empty_user_agent_suffix", WithUserAgentSuffix(" ")},
+		{"zero_timeout", WithTimeout(0)},
+		{"negative_max_attempts", WithRetryPolicy(&RetryPolicy{MaxAttempts: -1})},
+		{"invalid_jitter", WithRetryPolicy(&RetryPolicy{MaxAttempts: 3, Jitter: 2})},
+		{"max_backoff_lower_than_base", WithRetryPolicy(&RetryPolicy{BaseBackoff: time.Second, MaxBackoff: time.Millisecond})},
+		{"nil_logger", WithLogger(nil)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			client, err := NewClientWithOptions("re_123", c.opt)
+			assert.Error(t, err)
+			assert.Nil(t, client)
+
+			// NewClient fails every request with the error of the option
+			client = NewClient("re_123", c.opt)
+			assert.NotNil(t, client)
+			_, reqErr := client.NewRequest(context.Background(), http.MethodGet, "emails", nil)
+			assert.Equal(t, err, reqErr)
+			_, reqErr = client.NewMultipartRequest(context.Background(), "contacts/imports", []byte("email"), "contacts.csv", nil)
+			assert.Equal(t, err, reqErr)
+		})
+	}
+}
+
+func TestNewClientLogsInvalidOptions(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+
+	NewClient("re_123", WithTimeout(-1), WithLogger(logger), WithHeader("X-Tenant", "acme"))
+	assert.Contains(t, buf.String(), "invalid client option")
+	assert.Contains(t, buf.String(), "timeout must be positive")
+}
+
+// roundTripFunc is an http.RoundTripper calling a function
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestNewClientWithInvalidBaseURLNeverReachesDefaultHost(t *testing.T) {
+	requests := 0
+	transport := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		requests++
+		return nil, errors.New("unexpected request to " + r.URL.Host)
+	})
+
+	client := NewClient("re_123", WithHTTPClient(&http.Client{Transport: transport}), WithBaseURL("htps://staging.example.com"))
+	_, err := client.Emails.Send(&SendEmailRequest{From: "me@example.com", To: []string{"you@example.com"}, Subject: "Hi", Text: "Hi"})
+	assert.Error(t, err)
+	assert.Equal(t, 0, requests)
+}
+
+func TestNewClientWithBaseURLSendsRequests(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/emails/123", r.URL.Path)
+		assert.Equal(t, "acme", r.Header.Get("X-Tenant"))
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&Email{Id: "123"})
+	}))
+	defer srv.Close()
+
+	c := NewClient("re_123", WithBaseURL(srv.URL), WithHeader("X-Tenant", "acme"))
+	email, err := c.Emails.Get("123")
+	assert.NoError(t, err)
+	assert.Equal(t, "123", email.Id)
+}
@@ -0,0 +1,237 @@
+package outbox
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// FileStore is a Store appending every version of the messages to a file,
+// one JSON document per line. Each write is synced to disk before Put
+// returns, so acknowledged messages survive a crash of the process.
+//
+// The file is read in full when opened, and the latest version of every
+// message is kept in memory. A last line left incomplete by a crash is
+// ignored, but any other line that cannot be decoded fails OpenFileStore
+// with its line number, rather than dropping a message: the line must be
+// fixed or removed by hand. Use Compact to reclaim the space of old
+// versions and sent messages.
+//
+// A file must not be opened by more than one FileStore at a time.
+type FileStore struct {
+	mu       sync.Mutex
+	path     string
+	file     *os.File
+	messages map[string][]byte
+}
+
+// OpenFileStore opens or creates the file at path and loads its messages
+func OpenFileStore(path string) (*FileStore, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &FileStore{path: path, file: file, messages: make(map[string][]byte)}
+	if err := s.load(); err != nil {
+		file.Close()
+		return nil, err
+	}
+	// Make sure the entry of a created file is on disk
+	if err := syncDir(path); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// load reads the messages of the file and positions it for appending
+func (s *FileStore) load() error {
+	reader := bufio.NewReader(s.file)
+	var offset int64
+	for n := 1; ; n++ {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			// Drop an incomplete last line, written during a crash
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		var msg struct {
+			Id string `json:"id"`
+		}
+		if err := json.Unmarshal(line, &msg); err != nil {
+			return fmt.Errorf("[ERROR]: %s, line %d: %w", s.path, n, err)
+		}
+		s.messages[msg.Id] = bytes.TrimSpace(line)
+		offset += int64(len(line))
+	}
+
+	if err := s.file.Truncate(offset); err != nil {
+		return err
+	}
+	_, err := s.file.Seek(offset, io.SeekStart)
+	return err
+}
+
+// Put appends the message to the file
+func (s *FileStore) Put(ctx context.Context, msg *Message) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return os.ErrClosed
+	}
+	offset, err := s.file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := s.file.Write(append(data, '\n')); err != nil {
+		// Drop the partial line, so the next messages are not appended to it
+		if terr := s.file.Truncate(offset); terr == nil {
+			s.file.Seek(offset, io.SeekStart)
+		}
+		return err
+	}
+	if err := s.file.Sync(); err != nil {
+		return err
+	}
+	s.messages[msg.Id] = data
+	return nil
+}
+
+// Get returns the latest version of the message with the given id
+func (s *FileStore) Get(ctx context.Context, id string) (*Message, error) {
+	s.mu.Lock()
+	data, ok := s.messages[id]
+	s.mu.Unlock()
+
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return decodeMessage(data)
+}
+
+// Due returns the pending messages due at now
+func (s *FileStore) Due(ctx context.Context, now time.Time, limit int) ([]*Message, error) {
+	msgs, err := s.List(ctx, StatePending)
+	if err != nil {
+		return nil, err
+	}
+	return dueMessages(msgs, now, limit), nil
+}
+
+// List returns the messages in the given state
+func (s *FileStore) List(ctx context.Context, state State) ([]*Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var msgs []*Message
+	for _, data := range s.messages {
+		msg, err := decodeMessage(data)
+		if err != nil {
+			return nil, err
+		}
+		if msg.State == state {
+			msgs = append(msgs, msg)
+		}
+	}
+	sortByCreation(msgs)
+	return msgs, nil
+}
+
+// Compact rewrites the file with the latest version of every message,
+// dropping the messages sent before the given time. The new file replaces
+// the old one atomically.
+func (s *FileStore) Compact(sentBefore time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return os.ErrClosed
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	kept := make(map[string][]byte, len(s.messages))
+	writer := bufio.NewWriter(tmp)
+	for id, data := range s.messages {
+		msg, err := decodeMessage(data)
+		if err != nil {
+			tmp.Close()
+			return err
+		}
+		if msg.State == StateSent && msg.UpdatedAt.Before(sentBefore) {
+			continue
+		}
+		kept[id] = data
+		writer.Write(data)
+		writer.WriteByte('\n')
+	}
+	if err := writer.Flush(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	// The new file is at s.path from now on, even if the rename is not
+	// durable yet
+	s.file.Close()
+	s.file = tmp
+	s.messages = kept
+	if _, err := s.file.Seek(0, io.SeekEnd); err != nil {
+		return err
+	}
+
+	// The rename is only durable once the directory is synced
+	return syncDir(s.path)
+}
+
+// syncDir syncs the directory holding path, so its entries survive a crash
+func syncDir(path string) error {
+	dir, err := os.Open(filepath.Dir(path))
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
+
+// Close closes the file
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
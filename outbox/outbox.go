@@ -0,0 +1,378 @@
+// Package outbox persists emails before they are sent, so they survive
+// API outages and process restarts.
+//
+// Emails are enqueued into a Store, usually from a request handler, and a
+// worker drains the store in the background through Emails.SendWithOptions:
+//
+//	store, err := outbox.OpenFileStore("outbox.log")
+//	box := outbox.New(store, client.Emails, nil)
+//	go box.Run(ctx)
+//
+//	msg, err := box.Enqueue(ctx, params)
+//
+// Every message is sent with an idempotency key derived from its id, so a
+// message sent right before a crash is not delivered twice when the worker
+// sends it again, as long as it is within the 24 hours the API keeps the
+// keys. Failed messages are retried with an exponential backoff and moved
+// to the dead state once they fail permanently or too many times, where
+// they can be inspected with Dead and sent again with Requeue.
+package outbox
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/resend/resend-go/v3"
+)
+
+// State is the state of a message in the outbox
+type State string
+
+const (
+	// StatePending messages are waiting to be sent
+	StatePending State = "pending"
+	// StateSent messages were accepted by the API
+	StateSent State = "sent"
+	// StateDead messages failed permanently or too many times
+	StateDead State = "dead"
+)
+
+// Message is an email stored in the outbox
+type Message struct {
+	// Id identifies the message in the outbox
+	Id string `json:"id"`
+
+	// Request is the email to send
+	Request *resend.SendEmailRequest `json:"request"`
+
+	State State `json:"state"`
+
+	// Attempts is the number of failed attempts to send the message
+	Attempts int `json:"attempts"`
+
+	// NextAttempt is the time the message is due to be sent
+	NextAttempt time.Time `json:"next_attempt"`
+
+	// LastError is the error of the last failed attempt
+	LastError string `json:"last_error,omitempty"`
+
+	// Revision is incremented every time the request is changed by
+	// Requeue, to send it with a new idempotency key
+	Revision int `json:"revision,omitempty"`
+
+	// EmailId is the id of the email once sent
+	EmailId string `json:"email_id,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IdempotencyKey returns the idempotency key the message is sent with
+func (m *Message) IdempotencyKey() string {
+	if m.Revision > 0 {
+		return "outbox/" + m.Id + "/" + strconv.Itoa(m.Revision)
+	}
+	return "outbox/" + m.Id
+}
+
+// Options configures an Outbox
+type Options struct {
+	// MaxAttempts is the number of failed attempts after which a message is
+	// dead, 10 by default
+	MaxAttempts int
+
+	// BaseBackoff is the delay before the first retry, 1 second by
+	// default. It doubles on each subsequent attempt.
+	BaseBackoff time.Duration
+
+	// MaxBackoff caps the delay between attempts, 15 minutes by default
+	MaxBackoff time.Duration
+
+	// PollInterval is how often Run looks for due messages, 1 second by default
+	PollInterval time.Duration
+
+	// BatchSize is the maximum number of messages sent per poll, 10 by default
+	BatchSize int
+
+	// OnSent is called after a message is sent
+	OnSent func(msg *Message)
+
+	// OnFailure is called after a failed attempt, before the message is
+	// retried or moved to the dead state
+	OnFailure func(msg *Message, err error)
+
+	// OnDead is called when a message is moved to the dead state
+	OnDead func(msg *Message)
+}
+
+// Outbox stores emails and sends them in the background
+type Outbox struct {
+	store   Store
+	emails  resend.EmailsSvc
+	options Options
+}
+
+// timeNow is the clock of the outbox, replaced in tests
+var timeNow = time.Now
+
+// New returns an Outbox storing its messages in store and sending them
+// with emails, usually client.Emails. A nil options uses the defaults.
+func New(store Store, emails resend.EmailsSvc, options *Options) *Outbox {
+	o := &Outbox{store: store, emails: emails}
+	if options != nil {
+		o.options = *options
+	}
+	if o.options.MaxAttempts <= 0 {
+		o.options.MaxAttempts = 10
+	}
+	if o.options.BaseBackoff <= 0 {
+		o.options.BaseBackoff = time.Second
+	}
+	if o.options.MaxBackoff <= 0 {
+		o.options.MaxBackoff = 15 * time.Minute
+	}
+	if o.options.PollInterval <= 0 {
+		o.options.PollInterval = time.Second
+	}
+	if o.options.BatchSize <= 0 {
+		o.options.BatchSize = 10
+	}
+	return o
+}
+
+// Enqueue stores an email to be sent by the worker, with a random id
+func (o *Outbox) Enqueue(ctx context.Context, params *resend.SendEmailRequest) (*Message, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	return o.EnqueueWithId(ctx, hex.EncodeToString(id), params)
+}
+
+// EnqueueWithId stores an email to be sent by the worker with the given
+// id, ie: "password-reset/<token>". When a message with the same id is
+// already stored it is returned instead, so enqueuing is idempotent too.
+func (o *Outbox) EnqueueWithId(ctx context.Context, id string, params *resend.SendEmailRequest) (*Message, error) {
+	if id == "" {
+		return nil, errors.New("[ERROR]: id cannot be empty")
+	}
+	if params == nil {
+		return nil, errors.New("[ERROR]: params cannot be nil")
+	}
+
+	msg, err := o.store.Get(ctx, id)
+	if err == nil {
+		return msg, nil
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+
+	// The request is copied through its JSON encoding, so attachment
+	// Readers are read once and the stored message is self-contained
+	request, err := copyRequest(params)
+	if err != nil {
+		return nil, err
+	}
+
+	now := timeNow()
+	msg = &Message{
+		Id:          id,
+		Request:     request,
+		State:       StatePending,
+		NextAttempt: now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := o.store.Put(ctx, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// Get returns a stored message
+func (o *Outbox) Get(ctx context.Context, id string) (*Message, error) {
+	return o.store.Get(ctx, id)
+}
+
+// Dead returns the messages in the dead state
+func (o *Outbox) Dead(ctx context.Context) ([]*Message, error) {
+	return o.store.List(ctx, StateDead)
+}
+
+// Requeue moves a dead message back to the pending state, to be sent
+// again by the worker with a fresh count of attempts. The request can be
+// fixed by fn before it is stored, in which case it is sent with a new
+// idempotency key; fn may be nil.
+func (o *Outbox) Requeue(ctx context.Context, id string, fn func(*resend.SendEmailRequest)) error {
+	msg, err := o.store.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if msg.State != StateDead {
+		return errors.New("[ERROR]: only dead messages can be requeued")
+	}
+
+	if fn != nil {
+		fn(msg.Request)
+		msg.Revision++
+	}
+	msg.State = StatePending
+	msg.Attempts = 0
+	msg.NextAttempt = timeNow()
+	msg.UpdatedAt = msg.NextAttempt
+	return o.store.Put(ctx, msg)
+}
+
+// Run sends the due messages every PollInterval until the context is done.
+// It returns the context error, or the first error of the store.
+func (o *Outbox) Run(ctx context.Context) error {
+	ticker := time.NewTicker(o.options.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			n, err := o.Process(ctx)
+			if err != nil {
+				if ctxErr := ctx.Err(); ctxErr != nil {
+					return ctxErr
+				}
+				return err
+			}
+			// A full batch means more messages may be due
+			if n < o.options.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Process sends the messages due now, up to BatchSize, and returns how many
+// were attempted. Failed attempts are recorded on the messages; only the
+// errors of the store are returned.
+func (o *Outbox) Process(ctx context.Context) (int, error) {
+	due, err := o.store.Due(ctx, timeNow(), o.options.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, msg := range due {
+		if err := ctx.Err(); err != nil {
+			return i, err
+		}
+		if err := o.send(ctx, msg); err != nil {
+			return i + 1, err
+		}
+	}
+	return len(due), nil
+}
+
+// send makes an attempt to send a message and stores its outcome
+func (o *Outbox) send(ctx context.Context, msg *Message) error {
+	sent, err := o.emails.SendWithOptions(ctx, msg.Request, &resend.SendEmailOptions{
+		IdempotencyKey: msg.IdempotencyKey(),
+	})
+	if err != nil && ctx.Err() != nil {
+		// Stopping the worker is not a failure of the message
+		return ctx.Err()
+	}
+
+	now := timeNow()
+	msg.UpdatedAt = now
+	if err == nil {
+		msg.State = StateSent
+		msg.EmailId = sent.Id
+		msg.LastError = ""
+		if err := o.store.Put(ctx, msg); err != nil {
+			return err
+		}
+		if o.options.OnSent != nil {
+			o.options.OnSent(msg)
+		}
+		return nil
+	}
+
+	msg.Attempts++
+	msg.LastError = err.Error()
+	if o.options.OnFailure != nil {
+		o.options.OnFailure(msg, err)
+	}
+
+	if permanent(err) || msg.Attempts >= o.options.MaxAttempts {
+		msg.State = StateDead
+		if err := o.store.Put(ctx, msg); err != nil {
+			return err
+		}
+		if o.options.OnDead != nil {
+			o.options.OnDead(msg)
+		}
+		return nil
+	}
+
+	msg.NextAttempt = now.Add(o.backoff(msg.Attempts, err))
+	return o.store.Put(ctx, msg)
+}
+
+// backoff returns the delay before the next attempt of a message. The
+// delay requested by a rate limit error takes precedence.
+func (o *Outbox) backoff(attempts int, err error) time.Duration {
+	var rateLimitErr *resend.RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		if secs, err := strconv.Atoi(rateLimitErr.RetryAfter); err == nil && secs > 0 {
+			return time.Duration(secs) * time.Second
+		}
+	}
+
+	delay := o.options.BaseBackoff
+	for i := 1; i < attempts && delay < o.options.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, o.options.MaxBackoff)
+}
+
+// permanent reports whether sending the message again cannot succeed
+// without changing it: the email is invalid, or the request was rejected
+// for any other reason than a concurrent request with the same idempotency
+// key or the rate limit. Reusing the idempotency key of another payload is
+// permanent.
+func permanent(err error) bool {
+	if errors.Is(err, resend.ErrValidation) {
+		return true
+	}
+	var apiErr *resend.APIError
+	if errors.As(err, &apiErr) {
+		if errors.Is(err, resend.ErrConflict) {
+			return apiErr.Name == invalidIdempotentRequest
+		}
+		return apiErr.StatusCode >= 400 && apiErr.StatusCode < 500
+	}
+	return false
+}
+
+// invalidIdempotentRequest is the name of the conflict returned when an
+// idempotency key is reused with a different payload
+const invalidIdempotentRequest = "invalid_idempotent_request"
+
+// copyRequest returns a deep copy of the request
+func copyRequest(params *resend.SendEmailRequest) (*resend.SendEmailRequest, error) {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	request := new(resend.SendEmailRequest)
+	if err := json.Unmarshal(data, request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
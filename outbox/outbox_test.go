@@ -0,0 +1,314 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/resend/resend-go/v3"
+	"github.com/resend/resend-go/v3/resendtest"
+	"github.com/stretchr/testify/assert"
+)
+
+// fixClock sets the clock of the outbox and returns a function advancing it
+func fixClock(t *testing.T) func(time.Duration) {
+	now := time.Date(2024, 8, 5, 11, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	t.Cleanup(func() { timeNow = time.Now })
+	return func(d time.Duration) { now = now.Add(d) }
+}
+
+func email() *resend.SendEmailRequest {
+	return &resend.SendEmailRequest{
+		From:    "onboarding@resend.dev",
+		To:      []string{"jane@example.com"},
+		Subject: "Reset your password",
+		Text:    "Click the link",
+	}
+}
+
+func TestOutboxRetriesWithBackoff(t *testing.T) {
+	advance := fixClock(t)
+	srv := resendtest.NewServer()
+	defer srv.Close()
+	srv.InjectFault(resendtest.Fault{Method: http.MethodPost, Path: "/emails", StatusCode: http.StatusInternalServerError, Times: 2})
+
+	var failures []error
+	var sent []*Message
+	box := New(NewMemoryStore(), srv.Client().Emails, &Options{
+		OnFailure: func(msg *Message, err error) { failures = append(failures, err) },
+		OnSent:    func(msg *Message) { sent = append(sent, msg) },
+	})
+	ctx := context.Background()
+
+	msg, err := box.Enqueue(ctx, email())
+	assert.NoError(t, err)
+	assert.Equal(t, StatePending, msg.State)
+
+	n, err := box.Process(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	msg, _ = box.Get(ctx, msg.Id)
+	assert.Equal(t, 1, msg.Attempts)
+	assert.Equal(t, timeNow().Add(time.Second), msg.NextAttempt)
+	assert.Contains(t, msg.LastError, "unexpected error")
+
+	// Not due yet
+	n, _ = box.Process(ctx)
+	assert.Equal(t, 0, n)
+
+	advance(time.Second)
+	box.Process(ctx)
+	msg, _ = box.Get(ctx, msg.Id)
+	assert.Equal(t, 2, msg.Attempts)
+	assert.Equal(t, timeNow().Add(2*time.Second), msg.NextAttempt)
+
+	advance(2 * time.Second)
+	box.Process(ctx)
+	msg, _ = box.Get(ctx, msg.Id)
+	assert.Equal(t, StateSent, msg.State)
+	assert.Empty(t, msg.LastError)
+	assert.Len(t, failures, 2)
+	assert.True(t, errors.Is(failures[0], resend.ErrServer))
+	assert.Len(t, sent, 1)
+
+	delivered, ok := srv.Message(msg.EmailId)
+	assert.True(t, ok)
+	assert.Equal(t, "Reset your password", delivered.Request.Subject)
+}
+
+func TestOutboxIsCrashSafe(t *testing.T) {
+	srv := resendtest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	box := New(NewMemoryStore(), client.Emails, nil)
+	ctx := context.Background()
+
+	msg, err := box.EnqueueWithId(ctx, "password-reset/abc", email())
+	assert.NoError(t, err)
+	again, err := box.EnqueueWithId(ctx, "password-reset/abc", email())
+	assert.NoError(t, err)
+	assert.True(t, msg.CreatedAt.Equal(again.CreatedAt))
+
+	// The email was sent right before a crash, without recording it
+	first, err := client.Emails.SendWithOptions(ctx, msg.Request, &resend.SendEmailOptions{IdempotencyKey: msg.IdempotencyKey()})
+	assert.NoError(t, err)
+
+	_, err = box.Process(ctx)
+	assert.NoError(t, err)
+	msg, _ = box.Get(ctx, msg.Id)
+	assert.Equal(t, StateSent, msg.State)
+	assert.Equal(t, first.Id, msg.EmailId)
+	assert.Len(t, srv.Messages(), 1)
+}
+
+func TestOutboxDeadLetters(t *testing.T) {
+	advance := fixClock(t)
+	srv := resendtest.NewServer()
+	defer srv.Close()
+
+	var dead []string
+	box := New(NewMemoryStore(), srv.Client().Emails, &Options{
+		MaxAttempts: 2,
+		OnDead:      func(msg *Message) { dead = append(dead, msg.Id) },
+	})
+	ctx := context.Background()
+
+	// Rejected by the API, dead on the first attempt
+	invalid := email()
+	invalid.From = ""
+	rejected, err := box.Enqueue(ctx, invalid)
+	assert.NoError(t, err)
+	box.Process(ctx)
+
+	// Failing on every attempt, dead after MaxAttempts
+	srv.InjectFault(resendtest.Fault{Method: http.MethodPost, Path: "/emails", StatusCode: http.StatusServiceUnavailable})
+	failing, err := box.Enqueue(ctx, email())
+	assert.NoError(t, err)
+	box.Process(ctx)
+	advance(time.Second)
+	box.Process(ctx)
+	srv.ClearFaults()
+
+	msgs, err := box.Dead(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 2)
+	assert.Equal(t, []string{rejected.Id, failing.Id}, dead)
+	failing, _ = box.Get(ctx, failing.Id)
+	assert.Equal(t, 2, failing.Attempts)
+	rejected, _ = box.Get(ctx, rejected.Id)
+	assert.Equal(t, 1, rejected.Attempts)
+
+	assert.Error(t, box.Requeue(ctx, "missing", nil))
+	assert.NoError(t, box.Requeue(ctx, failing.Id, nil))
+	assert.NoError(t, box.Requeue(ctx, rejected.Id, func(r *resend.SendEmailRequest) {
+		r.From = "onboarding@resend.dev"
+	}))
+	assert.Error(t, box.Requeue(ctx, rejected.Id, nil))
+
+	n, err := box.Process(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	msgs, _ = box.Dead(ctx)
+	assert.Empty(t, msgs)
+	assert.Len(t, srv.Messages(), 2)
+}
+
+func TestOutboxRetriesConcurrentRequests(t *testing.T) {
+	advance := fixClock(t)
+	srv := resendtest.NewServer()
+	defer srv.Close()
+	srv.InjectFault(resendtest.Fault{Method: http.MethodPost, Path: "/emails", StatusCode: http.StatusConflict, Times: 1})
+
+	box := New(NewMemoryStore(), srv.Client().Emails, &Options{MaxAttempts: 2})
+	ctx := context.Background()
+
+	msg, err := box.Enqueue(ctx, email())
+	assert.NoError(t, err)
+	box.Process(ctx)
+	msg, _ = box.Get(ctx, msg.Id)
+	assert.Equal(t, StatePending, msg.State)
+	assert.Equal(t, 1, msg.Attempts)
+
+	advance(time.Second)
+	box.Process(ctx)
+	msg, _ = box.Get(ctx, msg.Id)
+	assert.Equal(t, StateSent, msg.State)
+}
+
+func TestOutboxDeadLettersReusedIdempotencyKey(t *testing.T) {
+	fixClock(t)
+	srv := resendtest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	var dead []string
+	box := New(NewMemoryStore(), client.Emails, &Options{
+		OnDead: func(msg *Message) { dead = append(dead, msg.Id) },
+	})
+	ctx := context.Background()
+
+	msg, err := box.Enqueue(ctx, email())
+	assert.NoError(t, err)
+
+	// Another payload was sent with the same idempotency key
+	other := email()
+	other.Subject = "Welcome"
+	_, err = client.Emails.SendWithOptions(ctx, other, &resend.SendEmailOptions{IdempotencyKey: msg.IdempotencyKey()})
+	assert.NoError(t, err)
+
+	box.Process(ctx)
+	msg, _ = box.Get(ctx, msg.Id)
+	assert.Equal(t, StateDead, msg.State)
+	assert.Equal(t, 1, msg.Attempts)
+	assert.Equal(t, []string{msg.Id}, dead)
+}
+
+func TestOutboxRun(t *testing.T) {
+	srv := resendtest.NewServer()
+	defer srv.Close()
+
+	sent := make(chan *Message, 1)
+	box := New(NewMemoryStore(), srv.Client().Emails, &Options{
+		PollInterval: 10 * time.Millisecond,
+		OnSent:       func(msg *Message) { sent <- msg },
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- box.Run(ctx) }()
+
+	msg, err := box.Enqueue(ctx, email())
+	assert.NoError(t, err)
+	select {
+	case got := <-sent:
+		assert.Equal(t, msg.Id, got.Id)
+	case <-time.After(5 * time.Second):
+		t.Fatal("message not sent")
+	}
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
+
+func TestFileStore(t *testing.T) {
+	advance := fixClock(t)
+	path := filepath.Join(t.TempDir(), "outbox.log")
+	ctx := context.Background()
+
+	store, err := OpenFileStore(path)
+	assert.NoError(t, err)
+
+	request := email()
+	request.Attachments = []*resend.Attachment{{Filename: "receipt.pdf", Content: []byte("%PDF")}}
+	first := &Message{Id: "1", Request: request, State: StatePending, CreatedAt: timeNow(), NextAttempt: timeNow()}
+	assert.NoError(t, store.Put(ctx, first))
+	advance(time.Minute)
+	second := &Message{Id: "2", Request: email(), State: StatePending, CreatedAt: timeNow(), NextAttempt: timeNow()}
+	assert.NoError(t, store.Put(ctx, second))
+	second.State, second.EmailId, second.UpdatedAt = StateSent, "e_1", timeNow()
+	assert.NoError(t, store.Put(ctx, second))
+	assert.NoError(t, store.Close())
+
+	// A crash left an incomplete line
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	assert.NoError(t, err)
+	f.WriteString(`{"id":"3","sta`)
+	f.Close()
+
+	store, err = OpenFileStore(path)
+	assert.NoError(t, err)
+	defer store.Close()
+
+	msg, err := store.Get(ctx, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("%PDF"), msg.Request.Attachments[0].Content)
+	msg, err = store.Get(ctx, "2")
+	assert.NoError(t, err)
+	assert.Equal(t, "e_1", msg.EmailId)
+	_, err = store.Get(ctx, "3")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	due, err := store.Due(ctx, timeNow(), 10)
+	assert.NoError(t, err)
+	assert.Len(t, due, 1)
+	assert.Equal(t, "1", due[0].Id)
+
+	assert.NoError(t, store.Compact(timeNow().Add(time.Second)))
+	_, err = store.Get(ctx, "2")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// Writes go to the compacted file
+	third := &Message{Id: "3", Request: email(), State: StateDead, CreatedAt: timeNow()}
+	assert.NoError(t, store.Put(ctx, third))
+	assert.NoError(t, store.Close())
+
+	store, err = OpenFileStore(path)
+	assert.NoError(t, err)
+	dead, err := store.List(ctx, StateDead)
+	assert.NoError(t, err)
+	assert.Len(t, dead, 1)
+	pending, err := store.List(ctx, StatePending)
+	assert.NoError(t, err)
+	assert.Len(t, pending, 1)
+	assert.NoError(t, store.Close())
+}
+
+func TestFileStoreCorruptLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "outbox.log")
+	data := `{"id":"1","state":"pending"}` + "\n" + `{"id":"2","sta` + "\n" + `{"id":"3","state":"pending"}` + "\n"
+	assert.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+
+	_, err := OpenFileStore(path)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "line 2")
+
+	// The file is left untouched
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, data, string(content))
+}
@@ -0,0 +1,130 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNotFound is returned by stores for unknown message ids
+var ErrNotFound = errors.New("[ERROR]: message not found in the outbox")
+
+// Store persists the messages of an Outbox.
+//
+// A store must copy the messages it is given and the ones it returns, so
+// callers can modify them freely. Implementations must be safe for
+// concurrent use.
+type Store interface {
+	// Put inserts a message or replaces the message with the same id
+	Put(ctx context.Context, msg *Message) error
+
+	// Get returns the message with the given id, or ErrNotFound
+	Get(ctx context.Context, id string) (*Message, error)
+
+	// Due returns up to limit pending messages whose NextAttempt is not
+	// after now, the earliest due first
+	Due(ctx context.Context, now time.Time, limit int) ([]*Message, error)
+
+	// List returns the messages in the given state, the oldest first
+	List(ctx context.Context, state State) ([]*Message, error)
+}
+
+// MemoryStore is a Store keeping the messages in memory, for tests and
+// processes that only need to survive API outages.
+type MemoryStore struct {
+	mu       sync.Mutex
+	messages map[string][]byte
+}
+
+// NewMemoryStore returns an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{messages: make(map[string][]byte)}
+}
+
+// Put stores a copy of the message
+func (s *MemoryStore) Put(ctx context.Context, msg *Message) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages[msg.Id] = data
+	return nil
+}
+
+// Get returns a copy of the message with the given id
+func (s *MemoryStore) Get(ctx context.Context, id string) (*Message, error) {
+	s.mu.Lock()
+	data, ok := s.messages[id]
+	s.mu.Unlock()
+
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return decodeMessage(data)
+}
+
+// Due returns copies of the pending messages due at now
+func (s *MemoryStore) Due(ctx context.Context, now time.Time, limit int) ([]*Message, error) {
+	msgs, err := s.List(ctx, StatePending)
+	if err != nil {
+		return nil, err
+	}
+	return dueMessages(msgs, now, limit), nil
+}
+
+// List returns copies of the messages in the given state
+func (s *MemoryStore) List(ctx context.Context, state State) ([]*Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var msgs []*Message
+	for _, data := range s.messages {
+		msg, err := decodeMessage(data)
+		if err != nil {
+			return nil, err
+		}
+		if msg.State == state {
+			msgs = append(msgs, msg)
+		}
+	}
+	sortByCreation(msgs)
+	return msgs, nil
+}
+
+func decodeMessage(data []byte) (*Message, error) {
+	msg := new(Message)
+	if err := json.Unmarshal(data, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// dueMessages returns up to limit pending messages due at now, the
+// earliest due first
+func dueMessages(msgs []*Message, now time.Time, limit int) []*Message {
+	msgs = slices.DeleteFunc(msgs, func(m *Message) bool {
+		return m.State != StatePending || m.NextAttempt.After(now)
+	})
+	slices.SortStableFunc(msgs, func(a, b *Message) int { return a.NextAttempt.Compare(b.NextAttempt) })
+	if limit > 0 && len(msgs) > limit {
+		msgs = msgs[:limit]
+	}
+	return msgs
+}
+
+// sortByCreation sorts messages the oldest first, by id for equal times
+func sortByCreation(msgs []*Message) {
+	slices.SortFunc(msgs, func(a, b *Message) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Id, b.Id)
+	})
+}
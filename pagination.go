@@ -0,0 +1,103 @@
+package resend
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// paginate returns an iterator over every item of a cursor-paginated list.
+//
+// Pages are fetched lazily with fetch, starting from the given cursor every
+// time the iterator is ranged over, and fetching stops as soon as the caller
+// breaks out of the loop. When the cursor has a Before value the list is
+// walked backwards: each page is requested before the first item of the
+// previous one and yielded in reverse order. A fetch error is yielded once and ends the iteration.
+func paginate[T any](ctx context.Context, start ListOptions, id func(T) string, fetch func(ctx context.Context, cursor ListOptions) ([]T, bool, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		cursor := start
+		reverse := cursor.Before != nil
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			items, hasMore, err := fetch(ctx, cursor)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			if reverse {
+				for i := len(items) - 1; i >= 0; i-- {
+					if !yield(items[i], nil) {
+						return
+					}
+				}
+			} else {
+				for _, item := range items {
+					if !yield(item, nil) {
+						return
+					}
+				}
+			}
+
+			if !hasMore || len(items) == 0 {
+				return
+			}
+
+			if reverse {
+				before := id(items[0])
+				cursor.Before = &before
+			} else {
+				after := id(items[len(items)-1])
+				cursor.After = &after
+			}
+		}
+	}
+}
+
+// listCursor returns a copy of the pagination options, so iterators can
+// advance the cursor without modifying the caller's options.
+func listCursor(options *ListOptions) ListOptions {
+	if options == nil {
+		return ListOptions{}
+	}
+	return *options
+}
+
+// paginationValues returns the query parameters of the pagination options
+func paginationValues(options ListOptions) url.Values {
+	query := make(url.Values)
+	if options.Limit != nil {
+		query.Set("limit", strconv.Itoa(*options.Limit))
+	}
+	if options.After != nil {
+		query.Set("after", *options.After)
+	}
+	if options.Before != nil {
+		query.Set("before", *options.Before)
+	}
+	return query
+}
+
+// Collect gathers up to max items from an iterator returned by the All
+// methods, fetching only the pages it needs. A max of 0 or less collects
+// every item. On error, the items collected so far are returned with it.
+func Collect[T any](seq iter.Seq2[T, error], max int) ([]T, error) {
+	var items []T
+	for item, err := range seq {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+		if max > 0 && len(items) == max {
+			break
+		}
+	}
+	return items, nil
+}
@@ -0,0 +1,279 @@
+package resend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"iter"
+	"net/http"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pagedHandler serves the given ids as a cursor-paginated list, newest first,
+// the same way the Resend API does.
+func pagedHandler(t *testing.T, ids []string, requests *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		*requests++
+
+		limit := 2
+		if l := r.URL.Query().Get("limit"); l != "" {
+			limit, _ = strconv.Atoi(l)
+		}
+
+		start, end := 0, len(ids)
+		if after := r.URL.Query().Get("after"); after != "" {
+			start = slices.Index(ids, after) + 1
+		}
+		if before := r.URL.Query().Get("before"); before != "" {
+			end = slices.Index(ids, before)
+			start = max(end-limit, 0)
+		}
+		page := ids[start:min(start+limit, end)]
+		hasMore := start+len(page) < end
+		if r.URL.Query().Get("before") != "" {
+			hasMore = start > 0
+		}
+
+		data := make([]map[string]string, len(page))
+		for i, id := range page {
+			data[i] = map[string]string{"id": id}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"object": "list", "has_more": hasMore, "data": data})
+	}
+}
+
+// collectIds drains an iterator into the ids of its items
+func collectIds[T any](t *testing.T, seq iter.Seq2[T, error], id func(T) string) []string {
+	var ids []string
+	for item, err := range seq {
+		assert.NoError(t, err)
+		ids = append(ids, id(item))
+	}
+	return ids
+}
+
+func TestPaginate(t *testing.T) {
+	pages := map[string][]string{"": {"a", "b"}, "b": {"c", "d"}, "d": {"e"}}
+	fetches := 0
+	fetch := func(ctx context.Context, cursor ListOptions) ([]string, bool, error) {
+		fetches++
+		after := ""
+		if cursor.After != nil {
+			after = *cursor.After
+		}
+		return pages[after], after != "d", nil
+	}
+	id := func(s string) string { return s }
+
+	items, err := Collect(paginate(context.Background(), ListOptions{}, id, fetch), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, items)
+	assert.Equal(t, 3, fetches)
+
+	// Pages are fetched lazily and fetching stops on break
+	fetches = 0
+	items, err = Collect(paginate(context.Background(), ListOptions{}, id, fetch), 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, items)
+	assert.Equal(t, 2, fetches)
+}
+
+func TestPaginateDoesNotModifyOptions(t *testing.T) {
+	after := "x"
+	options := &ListOptions{After: &after}
+	seq := paginate(context.Background(), listCursor(options), func(s string) string { return s },
+		func(ctx context.Context, cursor ListOptions) ([]string, bool, error) {
+			if *cursor.After == "x" {
+				return []string{"y"}, true, nil
+			}
+			return nil, false, nil
+		})
+
+	_, err := Collect(seq, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "x", *options.After)
+}
+
+func TestAllRangedTwice(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	ids := []string{"c5", "c4", "c3", "c2", "c1"}
+	mux.HandleFunc("/domains", pagedHandler(t, ids, &requests))
+	mux.HandleFunc("/contacts", pagedHandler(t, ids, &requests))
+	mux.HandleFunc("/automations", pagedHandler(t, ids, &requests))
+
+	domains := client.Domains.All(context.Background(), nil)
+	assert.Equal(t, ids, collectIds(t, domains, func(d Domain) string { return d.Id }))
+	assert.Equal(t, ids, collectIds(t, domains, func(d Domain) string { return d.Id }))
+
+	contacts := client.Contacts.All(context.Background(), nil)
+	assert.Equal(t, ids, collectIds(t, contacts, func(c Contact) string { return c.Id }))
+	assert.Equal(t, ids, collectIds(t, contacts, func(c Contact) string { return c.Id }))
+
+	automations := client.Automations.All(context.Background(), nil)
+	assert.Equal(t, ids, collectIds(t, automations, func(a AutomationListItem) string { return a.Id }))
+	assert.Equal(t, ids, collectIds(t, automations, func(a AutomationListItem) string { return a.Id }))
+	assert.Equal(t, 18, requests)
+}
+
+func TestPaginateYieldsErrors(t *testing.T) {
+	boom := errors.New("boom")
+	calls := 0
+	seq := paginate(context.Background(), ListOptions{}, func(s string) string { return s },
+		func(ctx context.Context, cursor ListOptions) ([]string, bool, error) {
+			calls++
+			if calls == 2 {
+				return nil, false, boom
+			}
+			return []string{"a"}, true, nil
+		})
+
+	items, err := Collect(seq, 0)
+	assert.ErrorIs(t, err, boom)
+	assert.Equal(t, []string{"a"}, items)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = Collect(paginate(ctx, ListOptions{}, func(s string) string { return s },
+		func(ctx context.Context, cursor ListOptions) ([]string, bool, error) {
+			t.Fatal("fetch must not be called with a cancelled context")
+			return nil, false, nil
+		}), 0)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestContactsAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	ids := []string{"c5", "c4", "c3", "c2", "c1"}
+	mux.HandleFunc("/segments/seg_1/contacts", pagedHandler(t, ids, &requests))
+
+	got := collectIds(t, client.Contacts.All(context.Background(), &ListContactsOptions{SegmentId: "seg_1"}),
+		func(c Contact) string { return c.Id })
+	assert.Equal(t, ids, got)
+	assert.Equal(t, 3, requests)
+}
+
+func TestContactsAllReverse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	ids := []string{"c5", "c4", "c3", "c2", "c1"}
+	mux.HandleFunc("/contacts", pagedHandler(t, ids, &requests))
+
+	before := "c1"
+	got := collectIds(t, client.Contacts.All(context.Background(), &ListContactsOptions{Before: &before}),
+		func(c Contact) string { return c.Id })
+	assert.Equal(t, []string{"c2", "c3", "c4", "c5"}, got)
+	assert.Equal(t, 2, requests)
+}
+
+func TestEmailsAllCollect(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/emails", pagedHandler(t, []string{"e4", "e3", "e2", "e1"}, &requests))
+
+	emails, err := Collect(client.Emails.All(context.Background(), nil), 2)
+	assert.NoError(t, err)
+	assert.Len(t, emails, 2)
+	assert.Equal(t, "e3", emails[1].Id)
+	assert.Equal(t, 1, requests)
+}
+
+func TestAllIterators(t *testing.T) {
+	ctx := context.Background()
+	ids := []string{"i3", "i2", "i1"}
+
+	cases := []struct {
+		path string
+		ids  func() []string
+	}{
+		{"/emails/e1/attachments", func() []string {
+			return collectIds(t, client.Emails.AllAttachments(ctx, "e1", nil), func(a EmailAttachment) string { return a.Id })
+		}},
+		{"/emails/receiving", func() []string {
+			return collectIds(t, client.Emails.Receiving.All(ctx, nil), func(e ListReceivedEmail) string { return e.Id })
+		}},
+		{"/emails/receiving/e1/attachments", func() []string {
+			return collectIds(t, client.Emails.Receiving.AllAttachments(ctx, "e1", nil), func(a EmailAttachment) string { return a.Id })
+		}},
+		{"/domains", func() []string {
+			return collectIds(t, client.Domains.All(ctx, nil), func(d Domain) string { return d.Id })
+		}},
+		{"/api-keys", func() []string {
+			return collectIds(t, client.ApiKeys.All(ctx, nil), func(k ApiKey) string { return k.Id })
+		}},
+		{"/webhooks", func() []string {
+			return collectIds(t, client.Webhooks.All(ctx, nil), func(w WebhookInList) string { return w.Id })
+		}},
+		{"/logs", func() []string {
+			return collectIds(t, client.Logs.All(ctx, nil), func(l Log) string { return l.Id })
+		}},
+		{"/broadcasts", func() []string {
+			return collectIds(t, client.Broadcasts.All(ctx, nil), func(b Broadcast) string { return b.Id })
+		}},
+		{"/templates", func() []string {
+			return collectIds(t, client.Templates.All(ctx, nil), func(tpl *TemplateListItem) string { return tpl.Id })
+		}},
+		{"/topics", func() []string {
+			return collectIds(t, client.Topics.All(ctx, nil), func(tp *Topic) string { return tp.Id })
+		}},
+		{"/segments", func() []string {
+			return collectIds(t, client.Segments.All(ctx, nil), func(s Segment) string { return s.Id })
+		}},
+		{"/suppressions", func() []string {
+			return collectIds(t, client.Suppressions.All(ctx, nil), func(s SuppressionListEntry) string { return s.Id })
+		}},
+		{"/automations", func() []string {
+			return collectIds(t, client.Automations.All(ctx, nil), func(a AutomationListItem) string { return a.Id })
+		}},
+		{"/automations/a1/runs", func() []string {
+			return collectIds(t, client.Automations.AllRuns(ctx, "a1", nil), func(r AutomationRunListItem) string { return r.Id })
+		}},
+		{"/events", func() []string {
+			return collectIds(t, client.Events.All(ctx, nil), func(e EventSummary) string { return e.Id })
+		}},
+		{"/oauth/grants", func() []string {
+			return collectIds(t, client.OAuthGrants.All(ctx, nil), func(g OAuthGrant) string { return g.Id })
+		}},
+		{"/contacts/imports", func() []string {
+			return collectIds(t, client.Contacts.Imports.All(ctx, nil), func(i ContactImport) string { return i.Id })
+		}},
+		{"/contact-properties", func() []string {
+			return collectIds(t, client.ContactProperties.All(ctx, nil), func(p ContactProperty) string { return p.Id })
+		}},
+		{"/contacts/c1/topics", func() []string {
+			return collectIds(t, client.Contacts.Topics.All(ctx, "c1", nil), func(tp ContactTopic) string { return tp.Id })
+		}},
+		{"/contacts/c1/segments", func() []string {
+			return collectIds(t, client.Contacts.Segments.All(ctx, &ListContactSegmentsRequest{ContactId: "c1"}, nil), func(s Segment) string { return s.Id })
+		}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.path, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			requests := 0
+			mux.HandleFunc(c.path, pagedHandler(t, ids, &requests))
+
+			assert.Equal(t, ids, c.ids())
+			assert.Equal(t, 2, requests)
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package resend
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a client-side token bucket that paces outgoing requests.
+//
+// When set on a Client, every service waits for a token before sending a
+// request, so goroutines sharing the client queue up instead of being
+// rejected with 429 responses. The limiter adapts to the ratelimit-limit,
+// ratelimit-remaining and ratelimit-reset headers returned by the API.
+// Resend expresses its limits per second, so ratelimit-limit is used as both
+// the refill rate and the burst size.
+type RateLimiter struct {
+	mu sync.Mutex
+
+	// rate is the number of tokens added per second
+	rate float64
+
+	// burst is the bucket capacity
+	burst float64
+
+	// tokens can go negative, representing callers queued for a token
+	tokens float64
+
+	// last is the time tokens were last refilled. It can be in the future
+	// when the API reported an exhausted window.
+	last time.Time
+}
+
+// NewRateLimiter returns a RateLimiter allowing requestsPerSecond requests
+// per second with bursts of up to burst requests.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	if requestsPerSecond <= 0 {
+		requestsPerSecond = 1
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request is allowed to proceed or the context is done.
+// It returns immediately with an error if the context deadline would expire
+// before a token becomes available.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	l.refill(now)
+	l.tokens--
+	wait := l.last.Sub(now)
+	if l.tokens < 0 {
+		wait += time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	if !sleepContext(ctx, wait) {
+		// Give the reserved token back
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return context.DeadlineExceeded
+	}
+	return nil
+}
+
+// RateLimitMiddleware returns a Middleware pacing requests with the given
+// limiter. A nil limiter leaves requests unpaced.
+func RateLimitMiddleware(limiter *RateLimiter) Middleware {
+	return func(next Handler) Handler {
+		if limiter == nil {
+			return next
+		}
+		return func(req *http.Request, ret any) (*http.Response, error) {
+			if err := limiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+
+			resp, err := next(req, ret)
+			if resp != nil {
+				limiter.observe(resp.Header)
+			} else {
+				limiter.observe(errorHeader(err))
+			}
+			return resp, err
+		}
+	}
+}
+
+// observe adapts the limiter to the rate limit headers of a response.
+func (l *RateLimiter) observe(header http.Header) {
+	if l == nil || header == nil {
+		return
+	}
+
+	limit, errLimit := strconv.ParseFloat(header.Get("ratelimit-limit"), 64)
+	remaining, errRemaining := strconv.ParseFloat(header.Get("ratelimit-remaining"), 64)
+	reset, errReset := strconv.ParseFloat(header.Get("ratelimit-reset"), 64)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.refill(now)
+
+	if errLimit == nil && limit > 0 {
+		l.rate = limit
+		l.burst = limit
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+
+	if errRemaining == nil && remaining < l.tokens {
+		l.tokens = remaining
+	}
+
+	// The window is exhausted: pause the refill until it resets
+	if errRemaining == nil && remaining <= 0 && errReset == nil && reset > 0 {
+		resetAt := now.Add(time.Duration(reset * float64(time.Second)))
+		if resetAt.After(l.last) {
+			l.last = resetAt
+		}
+	}
+}
+
+// refill adds the tokens accumulated since the last refill.
+// Must be called with l.mu held.
+func (l *RateLimiter) refill(now time.Time) {
+	if !now.After(l.last) {
+		return
+	}
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+}
@@ -0,0 +1,117 @@
+package resend
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiterWaitPacesRequests(t *testing.T) {
+	l := NewRateLimiter(100, 1)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, l.Wait(ctx))
+	}
+	// The first token is available immediately, the next four take 10ms each
+	assert.GreaterOrEqual(t, time.Since(start), 35*time.Millisecond)
+}
+
+func TestRateLimiterWaitAllowsBurst(t *testing.T) {
+	l := NewRateLimiter(1, 3)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, l.Wait(ctx))
+	}
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+}
+
+func TestRateLimiterWaitRespectsContextDeadline(t *testing.T) {
+	l := NewRateLimiter(1, 1)
+	assert.NoError(t, l.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := l.Wait(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+
+	// The reserved token is given back
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	assert.InDelta(t, 0, l.tokens, 0.1)
+}
+
+func TestRateLimiterNilIsNoop(t *testing.T) {
+	var l *RateLimiter
+	assert.NoError(t, l.Wait(context.Background()))
+	l.observe(http.Header{"Ratelimit-Limit": {"2"}})
+}
+
+func TestRateLimiterObserve(t *testing.T) {
+	l := NewRateLimiter(10, 10)
+	l.observe(http.Header{
+		"Ratelimit-Limit":     {"2"},
+		"Ratelimit-Remaining": {"1"},
+		"Ratelimit-Reset":     {"1"},
+	})
+
+	l.mu.Lock()
+	assert.Equal(t, 2.0, l.rate)
+	assert.Equal(t, 2.0, l.burst)
+	assert.InDelta(t, 1, l.tokens, 0.1)
+	l.mu.Unlock()
+
+	// An exhausted window pauses the refill until it resets
+	l.observe(http.Header{
+		"Ratelimit-Remaining": {"0"},
+		"Ratelimit-Reset":     {"5"},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.ErrorIs(t, l.Wait(ctx), context.DeadlineExceeded)
+}
+
+func TestClientRateLimiterSharedAcrossServices(t *testing.T) {
+	setup()
+	defer teardown()
+	client.RateLimiter = NewRateLimiter(100, 1)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"id": "1"})
+	}
+	mux.HandleFunc("/emails/1", handler)
+	mux.HandleFunc("/domains/1", handler)
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := client.Emails.Get("1")
+			assert.NoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := client.Domains.Get("1")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// Six requests at 100 req/s with no burst take at least 50ms
+	assert.GreaterOrEqual(t, time.Since(start), 45*time.Millisecond)
+}
@@ -2,6 +2,7 @@ package resend
 
 import (
 	"context"
+	"iter"
 	"net/http"
 	"net/url"
 )
@@ -24,6 +25,8 @@ type ReceivedEmail struct {
 	MessageId   string               `json:"message_id"`
 	Attachments []ReceivedAttachment `json:"attachments"`
 	Raw         RawEmail             `json:"raw"`
+
+	unknownFields
 }
 
 // ListReceivedEmail provides the structure for items in the Receiving.List call.
@@ -39,6 +42,8 @@ type ListReceivedEmail struct {
 	ReplyTo     []string             `json:"reply_to"`
 	MessageId   string               `json:"message_id"`
 	Attachments []ReceivedAttachment `json:"attachments"`
+
+	unknownFields
 }
 
 // ListReceivedEmailsResponse is the response from the Receiving.List call.
@@ -46,6 +51,8 @@ type ListReceivedEmailsResponse struct {
 	Object  string              `json:"object"`
 	HasMore bool                `json:"has_more"`
 	Data    []ListReceivedEmail `json:"data"`
+
+	unknownFields
 }
 
 // ReceivedAttachment represents an attachment in a received email (used in list responses without download URLs)
@@ -75,11 +82,13 @@ type ReceivingSvc interface {
 	ListWithOptions(ctx context.Context, options *ListOptions) (ListReceivedEmailsResponse, error)
 	ListWithContext(ctx context.Context) (ListReceivedEmailsResponse, error)
 	List() (ListReceivedEmailsResponse, error)
+	All(ctx context.Context, options *ListOptions) iter.Seq2[ListReceivedEmail, error]
 	GetAttachmentWithContext(ctx context.Context, emailId string, attachmentId string) (*EmailAttachment, error)
 	GetAttachment(emailId string, attachmentId string) (*EmailAttachment, error)
 	ListAttachmentsWithOptions(ctx context.Context, emailId string, options *ListOptions) (ListEmailAttachmentsResponse, error)
 	ListAttachmentsWithContext(ctx context.Context, emailId string) (ListEmailAttachmentsResponse, error)
 	ListAttachments(emailId string) (ListEmailAttachmentsResponse, error)
+	AllAttachments(ctx context.Context, emailId string, options *ListOptions) iter.Seq2[EmailAttachment, error]
 }
 
 // ReceivingSvcImpl is the implementation of the ReceivingSvc interface
@@ -158,6 +167,16 @@ func (s *ReceivingSvcImpl) List() (ListReceivedEmailsResponse, error) {
 	return s.ListWithContext(context.Background())
 }
 
+// All iterates over all received emails, fetching pages lazily
+// https://resend.com/docs/api-reference/emails/list-received-emails
+func (s *ReceivingSvcImpl) All(ctx context.Context, options *ListOptions) iter.Seq2[ListReceivedEmail, error] {
+	return paginate(ctx, listCursor(options), func(e ListReceivedEmail) string { return e.Id },
+		func(ctx context.Context, cursor ListOptions) ([]ListReceivedEmail, bool, error) {
+			resp, err := s.ListWithOptions(ctx, &cursor)
+			return resp.Data, resp.HasMore, err
+		})
+}
+
 // GetAttachmentWithContext retrieves a single attachment from a received email with the given emailId and attachmentId
 // https://resend.com/docs/api-reference/attachments/retrieve-received-email-attachment
 func (s *ReceivingSvcImpl) GetAttachmentWithContext(ctx context.Context, emailId string, attachmentId string) (*EmailAttachment, error) {
@@ -222,3 +241,13 @@ func (s *ReceivingSvcImpl) ListAttachmentsWithContext(ctx context.Context, email
 func (s *ReceivingSvcImpl) ListAttachments(emailId string) (ListEmailAttachmentsResponse, error) {
 	return s.ListAttachmentsWithContext(context.Background(), emailId)
 }
+
+// AllAttachments iterates over all attachments of a received email, fetching pages lazily
+// https://resend.com/docs/api-reference/attachments/list-received-email-attachments
+func (s *ReceivingSvcImpl) AllAttachments(ctx context.Context, emailId string, options *ListOptions) iter.Seq2[EmailAttachment, error] {
+	return paginate(ctx, listCursor(options), func(a EmailAttachment) string { return a.Id },
+		func(ctx context.Context, cursor ListOptions) ([]EmailAttachment, bool, error) {
+			resp, err := s.ListAttachmentsWithOptions(ctx, emailId, &cursor)
+			return resp.Data, resp.HasMore, err
+		})
+}
@@ -4,11 +4,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
-	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 	"time"
 )
@@ -17,6 +17,9 @@ const (
 	version     = "3.12.0"
 	userAgent   = "resend-go/" + version
 	contentType = "application/json"
+
+	// requestIdHeader is the response header identifying a request
+	requestIdHeader = "x-request-id"
 )
 
 var defaultBaseURL = getEnv("RESEND_BASE_URL", "https://api.resend.com/")
@@ -49,6 +52,43 @@ type Client struct {
 	// HTTP headers
 	headers map[string]string
 
+	// RetryPolicy controls automatic retries of failed requests.
+	// Retries are disabled when nil.
+	RetryPolicy *RetryPolicy
+
+	// RateLimiter paces requests across all services sharing this client.
+	// Requests are not paced when nil.
+	RateLimiter *RateLimiter
+
+	// Logger receives a structured record for every request.
+	// Nothing is logged when nil.
+	Logger *slog.Logger
+
+	// LogOptions controls what is logged and redacted when Logger is set
+	LogOptions LogOptions
+
+	// ValidateRequests runs SendEmailRequest.Validate before sending emails
+	// with Emails.Send and Batch.Send, failing with a *ValidationError
+	// instead of calling the API
+	ValidateRequests bool
+
+	// PreserveUnknownFields keeps the response fields the SDK does not
+	// model, returned by the Extra method of the response types. Responses
+	// are decoded twice to find them.
+	PreserveUnknownFields bool
+
+	// StrictDecoding fails responses having fields the SDK does not model
+	// with an *UnknownFieldsError. It is meant for contract tests detecting
+	// API changes.
+	StrictDecoding bool
+
+	// Middlewares wrapping every request, see Use
+	middlewares []Middleware
+
+	// optionErr is the error of the first invalid option given to
+	// NewClient, returned when building every request
+	optionErr error
+
 	// Services
 	Emails       *EmailsSvcImpl
 	Batch        BatchSvc
@@ -71,10 +111,22 @@ type Client struct {
 	Suppressions      *SuppressionsSvcImpl
 }
 
-// NewClient is the default client constructor
-func NewClient(apiKey string) *Client {
-	key := strings.Trim(strings.TrimSpace(apiKey), "'")
-	return NewCustomClient(defaultHTTPClient, key)
+// NewClient is the default client constructor.
+// When an option is invalid, every request of the client fails with its
+// error instead of reaching the API with a partial configuration; use
+// NewClientWithOptions to handle it when the client is built.
+func NewClient(apiKey string, opts ...ClientOption) *Client {
+	o, errs := collectOptions(opts)
+	c := o.build(apiKey)
+	if len(errs) > 0 {
+		c.optionErr = errs[0]
+		if c.Logger != nil {
+			for _, err := range errs {
+				c.Logger.Warn("resend: invalid client option", "error", err)
+			}
+		}
+	}
+	return c
 }
 
 // NewCustomClient builds a new Resend API client, using a provided Http client.
@@ -155,25 +207,49 @@ func (c *Client) NewRequestWithOptions(ctx context.Context, method, path string,
 // NewRequest builds and returns a new HTTP request object
 // based on the given arguments
 func (c *Client) NewRequest(ctx context.Context, method, path string, params any) (*http.Request, error) {
+	if c.optionErr != nil {
+		return nil, c.optionErr
+	}
+
 	u, err := c.BaseURL.Parse(path)
 	if err != nil {
 		return nil, err
 	}
 
-	var req *http.Request
-	req, err = http.NewRequestWithContext(ctx, method, u.String(), nil)
+	// Attachment Readers are streamed instead of being encoded upfront
+	stream, err := newStreamingBody(params)
 	if err != nil {
 		return nil, err
 	}
 
-	if params != nil {
+	// Encoding into a bytes.Reader lets net/http populate GetBody,
+	// so the body can be replayed between retry attempts.
+	var body io.Reader
+	if stream != nil {
+		body = stream.reader()
+	} else if params != nil {
 		buf := new(bytes.Buffer)
 		err = json.NewEncoder(buf).Encode(params)
 		if err != nil {
 			return nil, err
 		}
+		body = bytes.NewReader(buf.Bytes())
+	}
+
+	var req *http.Request
+	req, err = http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	if stream != nil {
+		req.ContentLength = stream.size
+		if stream.replayable() {
+			req.GetBody = stream.rewind
+		}
+	}
 
-		req.Body = io.NopCloser(buf)
+	if params != nil {
 		req.Header.Set("Content-Type", contentType)
 	}
 
@@ -188,20 +264,37 @@ func (c *Client) NewRequest(ctx context.Context, method, path string, params any
 	return req, nil
 }
 
-// Perform sends the request to the Resend API
+// Perform sends the request to the Resend API through the client's
+// middleware chain
 func (c *Client) Perform(req *http.Request, ret any) (*http.Response, error) {
+	return c.handler()(req, ret)
+}
+
+// send makes a single attempt of the request and decodes the response
+func (c *Client) send(req *http.Request, ret any) (*http.Response, error) {
 	resp, err := c.client.Do(req)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
+	collectResponseMeta(req.Context(), resp)
 
 	// Handle possible errors.
 	// Any 2xx status code is considered success
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
 		return nil, handleError(resp)
 	}
 
+	return decodeResponse(resp, ret, c.PreserveUnknownFields, c.StrictDecoding)
+}
+
+// decodeResponse decodes a successful response into ret and closes its body.
+// Fields unknown to ret are kept when preserve is set, or fail the decoding
+// with an *UnknownFieldsError when strict is set.
+func decodeResponse(resp *http.Response, ret any, preserve, strict bool) (*http.Response, error) {
+	defer resp.Body.Close()
+
+	var err error
 	if resp.StatusCode != http.StatusNoContent && ret != nil {
 		if w, ok := ret.(io.Writer); ok {
 			_, err = io.Copy(w, resp.Body)
@@ -209,11 +302,25 @@ func (c *Client) Perform(req *http.Request, ret any) (*http.Response, error) {
 				return nil, err
 			}
 		} else {
-			if resp.Body != nil {
+			if resp.Body != nil && !preserve && !strict {
 				err = json.NewDecoder(resp.Body).Decode(ret)
 				if err != nil {
 					return nil, err
 				}
+			} else if resp.Body != nil {
+				var data json.RawMessage
+				err = json.NewDecoder(resp.Body).Decode(&data)
+				if err != nil {
+					return nil, err
+				}
+				err = json.Unmarshal(data, ret)
+				if err != nil {
+					return nil, err
+				}
+				if unknown := decodeExtra(data, ret); strict && len(unknown) > 0 {
+					slices.Sort(unknown)
+					return nil, &UnknownFieldsError{Fields: unknown}
+				}
 			}
 		}
 	}
@@ -223,20 +330,24 @@ func (c *Client) Perform(req *http.Request, ret any) (*http.Response, error) {
 
 // handleError tries to handle errors based on HTTP status codes
 func handleError(resp *http.Response) error {
-	switch resp.StatusCode {
-
-	// Handle rate limit errors (429)
-	case http.StatusTooManyRequests:
-		r := &DefaultError{}
-		if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
-			err := json.NewDecoder(resp.Body).Decode(r)
-			if err != nil {
-				r.Message = resp.Status
-			}
-		} else {
+	body, _ := io.ReadAll(resp.Body)
+
+	// Tries to parse `name` and `message` attrs from error
+	r := &struct {
+		Name    string `json:"name"`
+		Message string `json:"message"`
+	}{}
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		err := json.Unmarshal(body, r)
+		if err != nil {
 			r.Message = resp.Status
 		}
+	} else {
+		r.Message = resp.Status
+	}
 
+	// Handle rate limit errors (429)
+	if resp.StatusCode == http.StatusTooManyRequests {
 		return &RateLimitError{
 			Message:    r.Message,
 			Limit:      resp.Header.Get("ratelimit-limit"),
@@ -244,39 +355,19 @@ func handleError(resp *http.Response) error {
 			Reset:      resp.Header.Get("ratelimit-reset"),
 			RetryAfter: resp.Header.Get("retry-after"),
 		}
+	}
 
-	// Handles errors most likely caused by the client
-	case http.StatusUnprocessableEntity, http.StatusBadRequest:
-		r := &InvalidRequestError{}
-		if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
-			err := json.NewDecoder(resp.Body).Decode(r)
-			if err != nil {
-				r.Message = resp.Status
-			}
-		} else {
-			r.Message = resp.Status
-		}
-
-		// TODO: replace this with a new ResendError type
-		return errors.New("[ERROR]: " + r.Message)
-	default:
-		// Tries to parse `message` attr from error
-		r := &DefaultError{}
-
-		if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
-			err := json.NewDecoder(resp.Body).Decode(r)
-			if err != nil {
-				r.Message = resp.Status
-			}
-		} else {
-			r.Message = resp.Status
-		}
+	if r.Message == "" {
+		r.Message = "Unknown Error"
+	}
 
-		if r.Message != "" {
-			// TODO: replace this with a new ResendError type
-			return errors.New("[ERROR]: " + r.Message)
-		}
-		return errors.New("[ERROR]: Unknown Error")
+	return &APIError{
+		StatusCode: resp.StatusCode,
+		Name:       r.Name,
+		Message:    r.Message,
+		RequestId:  resp.Header.Get(requestIdHeader),
+		Body:       body,
+		Header:     resp.Header,
 	}
 }
 
@@ -303,18 +394,7 @@ func buildPaginationQuery(options *ListOptions) string {
 		return ""
 	}
 
-	query := make(url.Values)
-	if options.Limit != nil {
-		query.Set("limit", fmt.Sprintf("%d", *options.Limit))
-	}
-	if options.After != nil {
-		query.Set("after", *options.After)
-	}
-	if options.Before != nil {
-		query.Set("before", *options.Before)
-	}
-
-	if len(query) > 0 {
+	if query := paginationValues(*options); len(query) > 0 {
 		return "?" + query.Encode()
 	}
 	return ""
@@ -87,7 +87,7 @@ func TestHandleError(t *testing.T) {
 				Header:     http.Header{"Content-Type": {"application/json; charset=utf-8"}},
 				Body:       io.NopCloser(bytes.NewBufferString(`{"message":"Validation error"}`)),
 			},
-			want: errors.New("[ERROR]: Validation error"),
+			want: &APIError{StatusCode: http.StatusUnprocessableEntity, Message: "Validation error"},
 		},
 		{
 			desc: "validation_error_no_json",
@@ -96,7 +96,7 @@ func TestHandleError(t *testing.T) {
 				Status:     fmt.Sprintf("%d %s", http.StatusUnprocessableEntity, http.StatusText(http.StatusUnprocessableEntity)),
 				Body:       io.NopCloser(bytes.NewBufferString(`Validation error`)),
 			},
-			want: errors.New("[ERROR]: 422 Unprocessable Entity"),
+			want: &APIError{StatusCode: http.StatusUnprocessableEntity, Message: "422 Unprocessable Entity"},
 		},
 		{
 			desc: "bad_request",
@@ -106,7 +106,7 @@ func TestHandleError(t *testing.T) {
 				Header:     http.Header{"Content-Type": {"application/json; charset=utf-8"}},
 				Body:       io.NopCloser(bytes.NewBufferString(`{"message":"Validation error"}`)),
 			},
-			want: errors.New("[ERROR]: Validation error"),
+			want: &APIError{StatusCode: http.StatusBadRequest, Message: "Validation error"},
 		},
 		{
 			desc: "bad_request_no_json",
@@ -115,7 +115,7 @@ func TestHandleError(t *testing.T) {
 				Status:     fmt.Sprintf("%d %s", http.StatusBadRequest, http.StatusText(http.StatusBadRequest)),
 				Body:       io.NopCloser(bytes.NewBufferString(`Validation error`)),
 			},
-			want: errors.New("[ERROR]: 400 Bad Request"),
+			want: &APIError{StatusCode: http.StatusBadRequest, Message: "400 Bad Request"},
 		},
 		{
 			desc: "bad_request_invalid_json",
@@ -125,7 +125,7 @@ func TestHandleError(t *testing.T) {
 				Header:     http.Header{"Content-Type": {"application/json; charset=utf-8"}},
 				Body:       io.NopCloser(bytes.NewBufferString(`{`)),
 			},
-			want: errors.New("[ERROR]: 400 Bad Request"),
+			want: &APIError{StatusCode: http.StatusBadRequest, Message: "400 Bad Request"},
 		},
 		{
 			desc: "server_error",
@@ -135,7 +135,7 @@ func TestHandleError(t *testing.T) {
 				Header:     http.Header{"Content-Type": {"application/json; charset=utf-8"}},
 				Body:       io.NopCloser(bytes.NewBufferString(`{"message":"Server error"}`)),
 			},
-			want: errors.New("[ERROR]: Server error"),
+			want: &APIError{StatusCode: http.StatusInternalServerError, Message: "Server error"},
 		},
 		{
 			desc: "server_error_no_json",
@@ -144,7 +144,7 @@ func TestHandleError(t *testing.T) {
 				Status:     fmt.Sprintf("%d %s", http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError)),
 				Body:       io.NopCloser(bytes.NewBufferString(`Server error`)),
 			},
-			want: errors.New("[ERROR]: 500 Internal Server Error"),
+			want: &APIError{StatusCode: http.StatusInternalServerError, Message: "500 Internal Server Error"},
 		},
 		{
 			desc: "server_error_invalid_json",
@@ -154,7 +154,7 @@ func TestHandleError(t *testing.T) {
 				Header:     http.Header{"Content-Type": {"application/json; charset=utf-8"}},
 				Body:       io.NopCloser(bytes.NewBufferString(`{`)),
 			},
-			want: errors.New("[ERROR]: 500 Internal Server Error"),
+			want: &APIError{StatusCode: http.StatusInternalServerError, Message: "500 Internal Server Error"},
 		},
 		{
 			desc: "server_error_no_message",
@@ -164,18 +164,73 @@ func TestHandleError(t *testing.T) {
 				Header:     http.Header{"Content-Type": {"application/json; charset=utf-8"}},
 				Body:       io.NopCloser(bytes.NewBufferString(`{}`)),
 			},
-			want: errors.New("[ERROR]: Unknown Error"),
+			want: &APIError{StatusCode: http.StatusInternalServerError, Message: "Unknown Error"},
 		},
 	}
 
 	for _, c := range cases {
 		t.Run(c.desc, func(t *testing.T) {
 			err := handleError(c.resp)
+
+			// The raw body and headers are covered by TestAPIError
+			var apiErr *APIError
+			if errors.As(err, &apiErr) {
+				apiErr.Body, apiErr.Header = nil, nil
+			}
 			assert.Equal(t, c.want, err)
 		})
 	}
 }
 
+func TestAPIError(t *testing.T) {
+	body := `{"statusCode":422,"name":"validation_error","message":"Invalid from field"}`
+	resp := &http.Response{
+		StatusCode: http.StatusUnprocessableEntity,
+		Status:     fmt.Sprintf("%d %s", http.StatusUnprocessableEntity, http.StatusText(http.StatusUnprocessableEntity)),
+		Header: http.Header{
+			"Content-Type": {"application/json; charset=utf-8"},
+			"X-Request-Id": {"req_123"},
+		},
+		Body: io.NopCloser(bytes.NewBufferString(body)),
+	}
+
+	err := handleError(resp)
+	assert.EqualError(t, err, "[ERROR]: Invalid from field")
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusUnprocessableEntity, apiErr.StatusCode)
+	assert.Equal(t, "validation_error", apiErr.Name)
+	assert.Equal(t, "Invalid from field", apiErr.Message)
+	assert.Equal(t, "req_123", apiErr.RequestId)
+	assert.Equal(t, body, string(apiErr.Body))
+	assert.Equal(t, resp.Header, apiErr.Header)
+}
+
+func TestAPIErrorIs(t *testing.T) {
+	cases := []struct {
+		status int
+		target error
+	}{
+		{http.StatusBadRequest, ErrValidation},
+		{http.StatusUnprocessableEntity, ErrValidation},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusConflict, ErrConflict},
+		{http.StatusInternalServerError, ErrServer},
+		{http.StatusServiceUnavailable, ErrServer},
+	}
+
+	sentinels := []error{ErrValidation, ErrUnauthorized, ErrForbidden, ErrNotFound, ErrConflict, ErrServer, ErrRateLimit}
+	for _, c := range cases {
+		err := error(&APIError{StatusCode: c.status})
+		for _, s := range sentinels {
+			assert.Equal(t, s == c.target, errors.Is(err, s), "status %d, sentinel %v", c.status, s)
+		}
+	}
+}
+
 func TestRateLimitErrorIs(t *testing.T) {
 	// Create a rate limit error
 	rateLimitErr := &RateLimitError{
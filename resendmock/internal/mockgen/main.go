@@ -0,0 +1,442 @@
+// Command mockgen generates the fakes of the resendmock package from the
+// service interfaces of the resend package. It starts from the resend.API
+// facade, so every service reachable through it gets a fake.
+//
+// Run it with go generate from the resendmock directory.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// service is a fake to generate
+type service struct {
+	// Iface is the name of the implemented interface, ie: EmailsAPI
+	Iface string
+
+	// Methods are the methods of the interface and of its embedded interfaces
+	Methods []*ast.Field
+
+	// Subs are the accessor methods of nested sub-services
+	Subs []accessor
+}
+
+// accessor is a method returning a service, ie: API.Emails
+type accessor struct {
+	Method string
+	Iface  string
+}
+
+// mockName returns the name of the fake implementing iface
+func mockName(iface string) string {
+	return strings.TrimSuffix(strings.TrimSuffix(iface, "Svc"), "API") + "Mock"
+}
+
+type generator struct {
+	fset       *token.FileSet
+	interfaces map[string]*ast.InterfaceType
+	imports    map[string]string
+	used       map[string]bool
+	services   map[string]*service
+	order      []string
+	buf        bytes.Buffer
+}
+
+func main() {
+	src := flag.String("src", "..", "directory of the resend package")
+	out := flag.String("out", "resendmock_gen.go", "output file")
+	flag.Parse()
+
+	g := &generator{
+		fset:       token.NewFileSet(),
+		interfaces: map[string]*ast.InterfaceType{},
+		imports:    map[string]string{},
+		used:       map[string]bool{},
+		services:   map[string]*service{},
+	}
+	if err := g.parse(*src); err != nil {
+		log.Fatal(err)
+	}
+
+	api := g.interfaces["API"]
+	if api == nil {
+		log.Fatal("resend.API interface not found")
+	}
+	var top []accessor
+	for _, m := range api.Methods.List {
+		top = append(top, accessor{Method: m.Names[0].Name, Iface: resultName(m)})
+	}
+	for _, a := range top {
+		g.addService(a.Iface)
+	}
+
+	g.generate(top)
+
+	code, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		log.Fatalf("formatting generated code: %v\n%s", err, g.buf.String())
+	}
+	if err := os.WriteFile(*out, code, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parse collects the interfaces and imports of the package in dir
+func (g *generator) parse(dir string) error {
+	pkgs, err := parser.ParseDir(g.fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	pkg, ok := pkgs["resend"]
+	if !ok {
+		return fmt.Errorf("package resend not found in %s", dir)
+	}
+	for _, f := range pkg.Files {
+		for _, imp := range f.Imports {
+			path, _ := strconv.Unquote(imp.Path.Value)
+			name := path[strings.LastIndex(path, "/")+1:]
+			if imp.Name != nil {
+				name = imp.Name.Name
+			}
+			g.imports[name] = path
+		}
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if it, ok := ts.Type.(*ast.InterfaceType); ok {
+					g.interfaces[ts.Name.Name] = it
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// addService registers the fake of iface and of its sub-services
+func (g *generator) addService(iface string) {
+	if _, ok := g.services[iface]; ok {
+		return
+	}
+	it := g.interfaces[iface]
+	if it == nil {
+		log.Fatalf("interface %s not found", iface)
+	}
+
+	s := &service{Iface: iface}
+	g.services[iface] = s
+	g.order = append(g.order, iface)
+
+	for _, m := range it.Methods.List {
+		switch {
+		case len(m.Names) == 0:
+			// Embedded service interface
+			s.Methods = append(s.Methods, g.methods(m.Type.(*ast.Ident).Name)...)
+		case strings.HasSuffix(resultName(m), "Svc"):
+			s.Subs = append(s.Subs, accessor{Method: m.Names[0].Name, Iface: resultName(m)})
+		default:
+			s.Methods = append(s.Methods, m)
+		}
+	}
+	for _, sub := range s.Subs {
+		g.addService(sub.Iface)
+	}
+}
+
+// methods returns the methods of an interface without embedded interfaces
+func (g *generator) methods(iface string) []*ast.Field {
+	it := g.interfaces[iface]
+	if it == nil {
+		log.Fatalf("interface %s not found", iface)
+	}
+	return it.Methods.List
+}
+
+// resultName returns the name of the single result type of a method
+func resultName(m *ast.Field) string {
+	ft, ok := m.Type.(*ast.FuncType)
+	if !ok || ft.Results == nil || len(ft.Results.List) != 1 {
+		return ""
+	}
+	if id, ok := ft.Results.List[0].Type.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
+
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) generate(top []accessor) {
+	// Client
+	g.printf("// Client is a fake of resend.API made of a fake per service.\n")
+	g.printf("// Create it with NewClient, then program the XxxFunc fields of the fakes.\n")
+	g.printf("type Client struct {\n")
+	g.printf("mu sync.Mutex\n\n")
+	for _, a := range top {
+		g.printf("%s *%s\n", mockName(a.Iface), mockName(a.Iface))
+	}
+	g.printf("}\n\n")
+	g.printf("var _ resend.API = (*Client)(nil)\n\n")
+
+	g.printf("// NewClient returns a Client with every fake, including nested ones, initialized.\n")
+	g.printf("func NewClient() *Client {\n")
+	g.printf("return &Client{\n")
+	for _, a := range top {
+		g.printf("%s: %s,\n", mockName(a.Iface), g.constructor(a.Iface))
+	}
+	g.printf("}\n}\n\n")
+
+	for _, a := range top {
+		g.accessor("Client", a, "c", "c.mu")
+	}
+
+	// Services
+	for _, iface := range g.order {
+		g.service(g.services[iface])
+	}
+
+	var header bytes.Buffer
+	fmt.Fprintf(&header, "// Code generated by mockgen; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&header, "package resendmock\n\n")
+	fmt.Fprintf(&header, "import (\n")
+	g.used["sync"] = true
+	var names []string
+	for name := range g.used {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		path := name
+		if p, ok := g.imports[name]; ok {
+			path = p
+		}
+		fmt.Fprintf(&header, "%q\n", path)
+	}
+	fmt.Fprintf(&header, "\n%q\n", "github.com/resend/resend-go/v3")
+	fmt.Fprintf(&header, ")\n\n")
+
+	code := append(header.Bytes(), g.buf.Bytes()...)
+	g.buf.Reset()
+	g.buf.Write(code)
+}
+
+// constructor returns the expression creating the fake of iface with its
+// nested fakes
+func (g *generator) constructor(iface string) string {
+	s := g.services[iface]
+	if len(s.Subs) == 0 {
+		return "&" + mockName(iface) + "{}"
+	}
+	var fields []string
+	for _, sub := range s.Subs {
+		fields = append(fields, mockName(sub.Iface)+": "+g.constructor(sub.Iface))
+	}
+	return "&" + mockName(iface) + "{" + strings.Join(fields, ", ") + "}"
+}
+
+// accessor generates a method returning a nested fake, creating it on first use
+func (g *generator) accessor(typ string, a accessor, recv, mu string) {
+	field := mockName(a.Iface)
+	g.printf("// %s returns %s, creating it on first use.\n", a.Method, field)
+	g.printf("func (%s *%s) %s() resend.%s {\n", recv, typ, a.Method, a.Iface)
+	g.printf("%s.Lock()\ndefer %s.Unlock()\n", mu, mu)
+	g.printf("if %s.%s == nil {\n%s.%s = %s\n}\n", recv, field, recv, field, g.constructor(a.Iface))
+	g.printf("return %s.%s\n}\n\n", recv, field)
+}
+
+// service generates the fake of a service
+func (g *generator) service(s *service) {
+	name := mockName(s.Iface)
+	short := strings.TrimSuffix(name, "Mock")
+
+	g.printf("// %s is a programmable fake of resend.%s.\n", name, s.Iface)
+	g.printf("// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.\n")
+	g.printf("type %s struct {\n", name)
+	g.printf("Recorder\n\n")
+	for _, sub := range s.Subs {
+		g.printf("%s *%s\n", mockName(sub.Iface), mockName(sub.Iface))
+	}
+	if len(s.Subs) > 0 {
+		g.printf("\n")
+	}
+	for _, m := range s.Methods {
+		g.printf("%sFunc %s\n", m.Names[0].Name, g.expr(m.Type))
+	}
+	g.printf("}\n\n")
+	g.printf("var _ resend.%s = (*%s)(nil)\n\n", s.Iface, name)
+
+	for _, sub := range s.Subs {
+		g.accessor(name, sub, "m", "m.mu")
+	}
+	for _, m := range s.Methods {
+		g.method(name, short, m)
+	}
+}
+
+// method generates a recorded method calling its XxxFunc field
+func (g *generator) method(typ, short string, m *ast.Field) {
+	name := m.Names[0].Name
+	ft := m.Type.(*ast.FuncType)
+
+	var params, args, call []string
+	i := 0
+	for _, p := range ft.Params.List {
+		names := p.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{ast.NewIdent("")}
+		}
+		for _, n := range names {
+			arg := n.Name
+			if arg == "" || arg == "_" {
+				arg = fmt.Sprintf("p%d", i)
+			}
+			i++
+			params = append(params, arg+" "+g.expr(p.Type))
+			args = append(args, arg)
+			if _, ok := p.Type.(*ast.Ellipsis); ok {
+				call = append(call, arg+"...")
+			} else {
+				call = append(call, arg)
+			}
+		}
+	}
+
+	var results []ast.Expr
+	if ft.Results != nil {
+		for _, r := range ft.Results.List {
+			for range max(len(r.Names), 1) {
+				results = append(results, r.Type)
+			}
+		}
+	}
+	var resultTypes []string
+	for _, r := range results {
+		resultTypes = append(resultTypes, g.expr(r))
+	}
+	sig := strings.Join(resultTypes, ", ")
+	if len(resultTypes) > 1 {
+		sig = "(" + sig + ")"
+	}
+
+	g.printf("// %s records the call and invokes %sFunc.\n", name, name)
+	g.printf("func (m *%s) %s(%s) %s {\n", typ, name, strings.Join(params, ", "), sig)
+	g.printf("m.record(%s)\n", strings.Join(append([]string{strconv.Quote(name)}, args...), ", "))
+	g.printf("if m.%sFunc == nil {\n", name)
+	g.notConfigured(short+"."+name, results, resultTypes)
+	g.printf("}\n")
+	if len(results) > 0 {
+		g.printf("return ")
+	}
+	g.printf("m.%sFunc(%s)\n}\n\n", name, strings.Join(call, ", "))
+}
+
+// notConfigured generates the return statement of an unconfigured method
+func (g *generator) notConfigured(method string, results []ast.Expr, types []string) {
+	err := fmt.Sprintf("notConfigured(%q)", method)
+	if len(results) == 0 {
+		g.printf("return\n")
+		return
+	}
+
+	last := results[len(results)-1]
+	if id, ok := last.(*ast.Ident); ok && id.Name == "error" {
+		var ret []string
+		for i, t := range types[:len(types)-1] {
+			g.printf("var r%d %s\n", i, t)
+			ret = append(ret, fmt.Sprintf("r%d", i))
+		}
+		g.printf("return %s\n", strings.Join(append(ret, err), ", "))
+		return
+	}
+
+	// Iterators yield the error instead
+	if ix, ok := last.(*ast.IndexListExpr); ok && len(results) == 1 {
+		if sel, ok := ix.X.(*ast.SelectorExpr); ok && sel.Sel.Name == "Seq2" {
+			g.printf("return notConfiguredSeq[%s](%s)\n", g.expr(ix.Indices[0]), err)
+			return
+		}
+	}
+
+	log.Fatalf("unsupported results for %s", method)
+}
+
+// expr prints a type of the resend package, qualifying its identifiers
+func (g *generator) expr(e ast.Expr) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, g.fset, g.qualify(e)); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
+
+// qualify returns a copy of a type expression with the exported identifiers
+// of the resend package qualified
+func (g *generator) qualify(e ast.Expr) ast.Expr {
+	switch t := e.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return &ast.SelectorExpr{X: ast.NewIdent("resend"), Sel: ast.NewIdent(t.Name)}
+		}
+		return ast.NewIdent(t.Name)
+	case *ast.SelectorExpr:
+		g.used[t.X.(*ast.Ident).Name] = true
+		return &ast.SelectorExpr{X: ast.NewIdent(t.X.(*ast.Ident).Name), Sel: ast.NewIdent(t.Sel.Name)}
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: g.qualify(t.X)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: t.Len, Elt: g.qualify(t.Elt)}
+	case *ast.MapType:
+		return &ast.MapType{Key: g.qualify(t.Key), Value: g.qualify(t.Value)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: g.qualify(t.Elt)}
+	case *ast.IndexExpr:
+		return &ast.IndexExpr{X: g.qualify(t.X), Index: g.qualify(t.Index)}
+	case *ast.IndexListExpr:
+		indices := make([]ast.Expr, len(t.Indices))
+		for i, idx := range t.Indices {
+			indices[i] = g.qualify(idx)
+		}
+		return &ast.IndexListExpr{X: g.qualify(t.X), Indices: indices}
+	case *ast.FuncType:
+		return &ast.FuncType{Params: g.qualifyFields(t.Params), Results: g.qualifyFields(t.Results)}
+	case *ast.InterfaceType:
+		return t
+	}
+	log.Fatalf("unsupported type expression %T", e)
+	return nil
+}
+
+func (g *generator) qualifyFields(fl *ast.FieldList) *ast.FieldList {
+	if fl == nil {
+		return nil
+	}
+	out := &ast.FieldList{}
+	for _, f := range fl.List {
+		var names []*ast.Ident
+		for _, n := range f.Names {
+			names = append(names, ast.NewIdent(n.Name))
+		}
+		out.List = append(out.List, &ast.Field{Names: names, Type: g.qualify(f.Type)})
+	}
+	return out
+}
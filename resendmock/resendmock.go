@@ -0,0 +1,101 @@
+// Package resendmock provides programmable fakes of the resend.API facade
+// and of every service reachable through it, so code using the Resend client
+// can be unit tested without an HTTP server.
+//
+// Every fake has a XxxFunc field per method of its interface. Calling a
+// method records the call and invokes the field, or returns an error
+// wrapping ErrNotConfigured when the field is nil:
+//
+//	mock := resendmock.NewClient()
+//	mock.EmailsMock.SendWithContextFunc = func(ctx context.Context, params *resend.SendEmailRequest) (*resend.SendEmailResponse, error) {
+//		return &resend.SendEmailResponse{Id: "email_123"}, nil
+//	}
+//
+//	notifier := NewNotifier(mock) // NewNotifier(api resend.API)
+//	notifier.Welcome(ctx, "jane@example.com")
+//
+//	calls := mock.EmailsMock.CallsTo("SendWithContext")
+//
+// Nested services are fakes too, ie: mock.ContactsMock.ContactTopicsMock
+// is returned by mock.Contacts().Topics().
+package resendmock
+
+//go:generate go run ./internal/mockgen -src .. -out resendmock_gen.go
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"sync"
+)
+
+// ErrNotConfigured is returned by the methods of a fake whose XxxFunc field is nil
+var ErrNotConfigured = errors.New("[ERROR]: resendmock method is not configured")
+
+// notConfigured returns the error of an unconfigured method
+func notConfigured(method string) error {
+	return fmt.Errorf("%w: %s", ErrNotConfigured, method)
+}
+
+// notConfiguredSeq returns an iterator yielding the error of an
+// unconfigured method
+func notConfiguredSeq[T any](err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, err)
+	}
+}
+
+// Call is a recorded method call
+type Call struct {
+	// Method is the name of the called method, ie: SendWithContext
+	Method string
+
+	// Args are the arguments of the call, including the context if any
+	Args []any
+}
+
+// Recorder records the calls made to a fake. It is safe for concurrent use.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// record appends a call
+func (r *Recorder) record(method string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns every recorded call, in order
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the recorded calls of the given method, in order
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var calls []Call
+	for _, c := range r.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// CallCount returns the number of recorded calls of the given method
+func (r *Recorder) CallCount(method string) int {
+	return len(r.CallsTo(method))
+}
+
+// Reset forgets every recorded call
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
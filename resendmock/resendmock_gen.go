@@ -0,0 +1,3201 @@
+// Code generated by mockgen; DO NOT EDIT.
+
+package resendmock
+
+import (
+	"context"
+	"iter"
+	"sync"
+
+	"github.com/resend/resend-go/v3"
+)
+
+// Client is a fake of resend.API made of a fake per service.
+// Create it with NewClient, then program the XxxFunc fields of the fakes.
+type Client struct {
+	mu sync.Mutex
+
+	EmailsMock            *EmailsMock
+	BatchMock             *BatchMock
+	ApiKeysMock           *ApiKeysMock
+	DomainsMock           *DomainsMock
+	DomainClaimsMock      *DomainClaimsMock
+	SegmentsMock          *SegmentsMock
+	AudiencesMock         *AudiencesMock
+	ContactsMock          *ContactsMock
+	ContactPropertiesMock *ContactPropertiesMock
+	BroadcastsMock        *BroadcastsMock
+	TemplatesMock         *TemplatesMock
+	TopicsMock            *TopicsMock
+	WebhooksMock          *WebhooksMock
+	LogsMock              *LogsMock
+	AutomationsMock       *AutomationsMock
+	EventsMock            *EventsMock
+	OAuthGrantsMock       *OAuthGrantsMock
+	SuppressionsMock      *SuppressionsMock
+}
+
+var _ resend.API = (*Client)(nil)
+
+// NewClient returns a Client with every fake, including nested ones, initialized.
+func NewClient() *Client {
+	return &Client{
+		EmailsMock:            &EmailsMock{ReceivingMock: &ReceivingMock{}},
+		BatchMock:             &BatchMock{},
+		ApiKeysMock:           &ApiKeysMock{},
+		DomainsMock:           &DomainsMock{},
+		DomainClaimsMock:      &DomainClaimsMock{},
+		SegmentsMock:          &SegmentsMock{},
+		AudiencesMock:         &AudiencesMock{},
+		ContactsMock:          &ContactsMock{ContactTopicsMock: &ContactTopicsMock{}, ContactSegmentsMock: &ContactSegmentsMock{}, ContactPropertiesMock: &ContactPropertiesMock{}, ContactImportsMock: &ContactImportsMock{}},
+		ContactPropertiesMock: &ContactPropertiesMock{},
+		BroadcastsMock:        &BroadcastsMock{},
+		TemplatesMock:         &TemplatesMock{},
+		TopicsMock:            &TopicsMock{},
+		WebhooksMock:          &WebhooksMock{},
+		LogsMock:              &LogsMock{},
+		AutomationsMock:       &AutomationsMock{},
+		EventsMock:            &EventsMock{},
+		OAuthGrantsMock:       &OAuthGrantsMock{},
+		SuppressionsMock:      &SuppressionsMock{SuppressionsBatchMock: &SuppressionsBatchMock{}},
+	}
+}
+
+// Emails returns EmailsMock, creating it on first use.
+func (c *Client) Emails() resend.EmailsAPI {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.EmailsMock == nil {
+		c.EmailsMock = &EmailsMock{ReceivingMock: &ReceivingMock{}}
+	}
+	return c.EmailsMock
+}
+
+// Batch returns BatchMock, creating it on first use.
+func (c *Client) Batch() resend.BatchSvc {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.BatchMock == nil {
+		c.BatchMock = &BatchMock{}
+	}
+	return c.BatchMock
+}
+
+// ApiKeys returns ApiKeysMock, creating it on first use.
+func (c *Client) ApiKeys() resend.ApiKeysSvc {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.ApiKeysMock == nil {
+		c.ApiKeysMock = &ApiKeysMock{}
+	}
+	return c.ApiKeysMock
+}
+
+// Domains returns DomainsMock, creating it on first use.
+func (c *Client) Domains() resend.DomainsSvc {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.DomainsMock == nil {
+		c.DomainsMock = &DomainsMock{}
+	}
+	return c.DomainsMock
+}
+
+// DomainClaims returns DomainClaimsMock, creating it on first use.
+func (c *Client) DomainClaims() resend.DomainClaimsSvc {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.DomainClaimsMock == nil {
+		c.DomainClaimsMock = &DomainClaimsMock{}
+	}
+	return c.DomainClaimsMock
+}
+
+// Segments returns SegmentsMock, creating it on first use.
+func (c *Client) Segments() resend.SegmentsSvc {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.SegmentsMock == nil {
+		c.SegmentsMock = &SegmentsMock{}
+	}
+	return c.SegmentsMock
+}
+
+// Audiences returns AudiencesMock, creating it on first use.
+func (c *Client) Audiences() resend.AudiencesSvc {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.AudiencesMock == nil {
+		c.AudiencesMock = &AudiencesMock{}
+	}
+	return c.AudiencesMock
+}
+
+// Contacts returns ContactsMock, creating it on first use.
+func (c *Client) Contacts() resend.ContactsAPI {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.ContactsMock == nil {
+		c.ContactsMock = &ContactsMock{ContactTopicsMock: &ContactTopicsMock{}, ContactSegmentsMock: &ContactSegmentsMock{}, ContactPropertiesMock: &ContactPropertiesMock{}, ContactImportsMock: &ContactImportsMock{}}
+	}
+	return c.ContactsMock
+}
+
+// ContactProperties returns ContactPropertiesMock, creating it on first use.
+func (c *Client) ContactProperties() resend.ContactPropertiesSvc {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.ContactPropertiesMock == nil {
+		c.ContactPropertiesMock = &ContactPropertiesMock{}
+	}
+	return c.ContactPropertiesMock
+}
+
+// Broadcasts returns BroadcastsMock, creating it on first use.
+func (c *Client) Broadcasts() resend.BroadcastsSvc {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.BroadcastsMock == nil {
+		c.BroadcastsMock = &BroadcastsMock{}
+	}
+	return c.BroadcastsMock
+}
+
+// Templates returns TemplatesMock, creating it on first use.
+func (c *Client) Templates() resend.TemplatesSvc {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.TemplatesMock == nil {
+		c.TemplatesMock = &TemplatesMock{}
+	}
+	return c.TemplatesMock
+}
+
+// Topics returns TopicsMock, creating it on first use.
+func (c *Client) Topics() resend.TopicsSvc {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.TopicsMock == nil {
+		c.TopicsMock = &TopicsMock{}
+	}
+	return c.TopicsMock
+}
+
+// Webhooks returns WebhooksMock, creating it on first use.
+func (c *Client) Webhooks() resend.WebhooksSvc {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.WebhooksMock == nil {
+		c.WebhooksMock = &WebhooksMock{}
+	}
+	return c.WebhooksMock
+}
+
+// Logs returns LogsMock, creating it on first use.
+func (c *Client) Logs() resend.LogsSvc {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.LogsMock == nil {
+		c.LogsMock = &LogsMock{}
+	}
+	return c.LogsMock
+}
+
+// Automations returns AutomationsMock, creating it on first use.
+func (c *Client) Automations() resend.AutomationsSvc {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.AutomationsMock == nil {
+		c.AutomationsMock = &AutomationsMock{}
+	}
+	return c.AutomationsMock
+}
+
+// Events returns EventsMock, creating it on first use.
+func (c *Client) Events() resend.EventsSvc {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.EventsMock == nil {
+		c.EventsMock = &EventsMock{}
+	}
+	return c.EventsMock
+}
+
+// OAuthGrants returns OAuthGrantsMock, creating it on first use.
+func (c *Client) OAuthGrants() resend.OAuthGrantsSvc {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.OAuthGrantsMock == nil {
+		c.OAuthGrantsMock = &OAuthGrantsMock{}
+	}
+	return c.OAuthGrantsMock
+}
+
+// Suppressions returns SuppressionsMock, creating it on first use.
+func (c *Client) Suppressions() resend.SuppressionsAPI {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.SuppressionsMock == nil {
+		c.SuppressionsMock = &SuppressionsMock{SuppressionsBatchMock: &SuppressionsBatchMock{}}
+	}
+	return c.SuppressionsMock
+}
+
+// EmailsMock is a programmable fake of resend.EmailsAPI.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type EmailsMock struct {
+	Recorder
+
+	ReceivingMock *ReceivingMock
+
+	CancelWithContextFunc          func(ctx context.Context, emailId string) (*resend.CancelScheduledEmailResponse, error)
+	CancelFunc                     func(emailId string) (*resend.CancelScheduledEmailResponse, error)
+	ShareWithContextFunc           func(ctx context.Context, emailId string, params *resend.ShareEmailRequest) (*resend.ShareEmailResponse, error)
+	ShareFunc                      func(emailId string, params *resend.ShareEmailRequest) (*resend.ShareEmailResponse, error)
+	UpdateWithContextFunc          func(ctx context.Context, params *resend.UpdateEmailRequest) (*resend.UpdateEmailResponse, error)
+	UpdateFunc                     func(params *resend.UpdateEmailRequest) (*resend.UpdateEmailResponse, error)
+	SendWithOptionsFunc            func(ctx context.Context, params *resend.SendEmailRequest, options *resend.SendEmailOptions) (*resend.SendEmailResponse, error)
+	SendWithContextFunc            func(ctx context.Context, params *resend.SendEmailRequest) (*resend.SendEmailResponse, error)
+	SendFunc                       func(params *resend.SendEmailRequest) (*resend.SendEmailResponse, error)
+	GetWithContextFunc             func(ctx context.Context, emailId string) (*resend.Email, error)
+	GetFunc                        func(emailId string) (*resend.Email, error)
+	WaitForStatusFunc              func(ctx context.Context, emailId string, options *resend.WaitForStatusOptions) (*resend.WaitForStatusResponse, error)
+	ListWithOptionsFunc            func(ctx context.Context, options *resend.ListOptions) (resend.ListEmailsResponse, error)
+	ListWithContextFunc            func(ctx context.Context) (resend.ListEmailsResponse, error)
+	ListFunc                       func() (resend.ListEmailsResponse, error)
+	AllFunc                        func(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.Email, error]
+	GetAttachmentWithContextFunc   func(ctx context.Context, emailId string, attachmentId string) (*resend.EmailAttachment, error)
+	GetAttachmentFunc              func(emailId string, attachmentId string) (*resend.EmailAttachment, error)
+	ListAttachmentsWithOptionsFunc func(ctx context.Context, emailId string, options *resend.ListOptions) (resend.ListEmailAttachmentsResponse, error)
+	ListAttachmentsWithContextFunc func(ctx context.Context, emailId string) (resend.ListEmailAttachmentsResponse, error)
+	ListAttachmentsFunc            func(emailId string) (resend.ListEmailAttachmentsResponse, error)
+	AllAttachmentsFunc             func(ctx context.Context, emailId string, options *resend.ListOptions) iter.Seq2[resend.EmailAttachment, error]
+}
+
+var _ resend.EmailsAPI = (*EmailsMock)(nil)
+
+// Receiving returns ReceivingMock, creating it on first use.
+func (m *EmailsMock) Receiving() resend.ReceivingSvc {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.ReceivingMock == nil {
+		m.ReceivingMock = &ReceivingMock{}
+	}
+	return m.ReceivingMock
+}
+
+// CancelWithContext records the call and invokes CancelWithContextFunc.
+func (m *EmailsMock) CancelWithContext(ctx context.Context, emailId string) (*resend.CancelScheduledEmailResponse, error) {
+	m.record("CancelWithContext", ctx, emailId)
+	if m.CancelWithContextFunc == nil {
+		var r0 *resend.CancelScheduledEmailResponse
+		return r0, notConfigured("Emails.CancelWithContext")
+	}
+	return m.CancelWithContextFunc(ctx, emailId)
+}
+
+// Cancel records the call and invokes CancelFunc.
+func (m *EmailsMock) Cancel(emailId string) (*resend.CancelScheduledEmailResponse, error) {
+	m.record("Cancel", emailId)
+	if m.CancelFunc == nil {
+		var r0 *resend.CancelScheduledEmailResponse
+		return r0, notConfigured("Emails.Cancel")
+	}
+	return m.CancelFunc(emailId)
+}
+
+// ShareWithContext records the call and invokes ShareWithContextFunc.
+func (m *EmailsMock) ShareWithContext(ctx context.Context, emailId string, params *resend.ShareEmailRequest) (*resend.ShareEmailResponse, error) {
+	m.record("ShareWithContext", ctx, emailId, params)
+	if m.ShareWithContextFunc == nil {
+		var r0 *resend.ShareEmailResponse
+		return r0, notConfigured("Emails.ShareWithContext")
+	}
+	return m.ShareWithContextFunc(ctx, emailId, params)
+}
+
+// Share records the call and invokes ShareFunc.
+func (m *EmailsMock) Share(emailId string, params *resend.ShareEmailRequest) (*resend.ShareEmailResponse, error) {
+	m.record("Share", emailId, params)
+	if m.ShareFunc == nil {
+		var r0 *resend.ShareEmailResponse
+		return r0, notConfigured("Emails.Share")
+	}
+	return m.ShareFunc(emailId, params)
+}
+
+// UpdateWithContext records the call and invokes UpdateWithContextFunc.
+func (m *EmailsMock) UpdateWithContext(ctx context.Context, params *resend.UpdateEmailRequest) (*resend.UpdateEmailResponse, error) {
+	m.record("UpdateWithContext", ctx, params)
+	if m.UpdateWithContextFunc == nil {
+		var r0 *resend.UpdateEmailResponse
+		return r0, notConfigured("Emails.UpdateWithContext")
+	}
+	return m.UpdateWithContextFunc(ctx, params)
+}
+
+// Update records the call and invokes UpdateFunc.
+func (m *EmailsMock) Update(params *resend.UpdateEmailRequest) (*resend.UpdateEmailResponse, error) {
+	m.record("Update", params)
+	if m.UpdateFunc == nil {
+		var r0 *resend.UpdateEmailResponse
+		return r0, notConfigured("Emails.Update")
+	}
+	return m.UpdateFunc(params)
+}
+
+// SendWithOptions records the call and invokes SendWithOptionsFunc.
+func (m *EmailsMock) SendWithOptions(ctx context.Context, params *resend.SendEmailRequest, options *resend.SendEmailOptions) (*resend.SendEmailResponse, error) {
+	m.record("SendWithOptions", ctx, params, options)
+	if m.SendWithOptionsFunc == nil {
+		var r0 *resend.SendEmailResponse
+		return r0, notConfigured("Emails.SendWithOptions")
+	}
+	return m.SendWithOptionsFunc(ctx, params, options)
+}
+
+// SendWithContext records the call and invokes SendWithContextFunc.
+func (m *EmailsMock) SendWithContext(ctx context.Context, params *resend.SendEmailRequest) (*resend.SendEmailResponse, error) {
+	m.record("SendWithContext", ctx, params)
+	if m.SendWithContextFunc == nil {
+		var r0 *resend.SendEmailResponse
+		return r0, notConfigured("Emails.SendWithContext")
+	}
+	return m.SendWithContextFunc(ctx, params)
+}
+
+// Send records the call and invokes SendFunc.
+func (m *EmailsMock) Send(params *resend.SendEmailRequest) (*resend.SendEmailResponse, error) {
+	m.record("Send", params)
+	if m.SendFunc == nil {
+		var r0 *resend.SendEmailResponse
+		return r0, notConfigured("Emails.Send")
+	}
+	return m.SendFunc(params)
+}
+
+// GetWithContext records the call and invokes GetWithContextFunc.
+func (m *EmailsMock) GetWithContext(ctx context.Context, emailId string) (*resend.Email, error) {
+	m.record("GetWithContext", ctx, emailId)
+	if m.GetWithContextFunc == nil {
+		var r0 *resend.Email
+		return r0, notConfigured("Emails.GetWithContext")
+	}
+	return m.GetWithContextFunc(ctx, emailId)
+}
+
+// Get records the call and invokes GetFunc.
+func (m *EmailsMock) Get(emailId string) (*resend.Email, error) {
+	m.record("Get", emailId)
+	if m.GetFunc == nil {
+		var r0 *resend.Email
+		return r0, notConfigured("Emails.Get")
+	}
+	return m.GetFunc(emailId)
+}
+
+// WaitForStatus records the call and invokes WaitForStatusFunc.
+func (m *EmailsMock) WaitForStatus(ctx context.Context, emailId string, options *resend.WaitForStatusOptions) (*resend.WaitForStatusResponse, error) {
+	m.record("WaitForStatus", ctx, emailId, options)
+	if m.WaitForStatusFunc == nil {
+		var r0 *resend.WaitForStatusResponse
+		return r0, notConfigured("Emails.WaitForStatus")
+	}
+	return m.WaitForStatusFunc(ctx, emailId, options)
+}
+
+// ListWithOptions records the call and invokes ListWithOptionsFunc.
+func (m *EmailsMock) ListWithOptions(ctx context.Context, options *resend.ListOptions) (resend.ListEmailsResponse, error) {
+	m.record("ListWithOptions", ctx, options)
+	if m.ListWithOptionsFunc == nil {
+		var r0 resend.ListEmailsResponse
+		return r0, notConfigured("Emails.ListWithOptions")
+	}
+	return m.ListWithOptionsFunc(ctx, options)
+}
+
+// ListWithContext records the call and invokes ListWithContextFunc.
+func (m *EmailsMock) ListWithContext(ctx context.Context) (resend.ListEmailsResponse, error) {
+	m.record("ListWithContext", ctx)
+	if m.ListWithContextFunc == nil {
+		var r0 resend.ListEmailsResponse
+		return r0, notConfigured("Emails.ListWithContext")
+	}
+	return m.ListWithContextFunc(ctx)
+}
+
+// List records the call and invokes ListFunc.
+func (m *EmailsMock) List() (resend.ListEmailsResponse, error) {
+	m.record("List")
+	if m.ListFunc == nil {
+		var r0 resend.ListEmailsResponse
+		return r0, notConfigured("Emails.List")
+	}
+	return m.ListFunc()
+}
+
+// All records the call and invokes AllFunc.
+func (m *EmailsMock) All(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.Email, error] {
+	m.record("All", ctx, options)
+	if m.AllFunc == nil {
+		return notConfiguredSeq[resend.Email](notConfigured("Emails.All"))
+	}
+	return m.AllFunc(ctx, options)
+}
+
+// GetAttachmentWithContext records the call and invokes GetAttachmentWithContextFunc.
+func (m *EmailsMock) GetAttachmentWithContext(ctx context.Context, emailId string, attachmentId string) (*resend.EmailAttachment, error) {
+	m.record("GetAttachmentWithContext", ctx, emailId, attachmentId)
+	if m.GetAttachmentWithContextFunc == nil {
+		var r0 *resend.EmailAttachment
+		return r0, notConfigured("Emails.GetAttachmentWithContext")
+	}
+	return m.GetAttachmentWithContextFunc(ctx, emailId, attachmentId)
+}
+
+// GetAttachment records the call and invokes GetAttachmentFunc.
+func (m *EmailsMock) GetAttachment(emailId string, attachmentId string) (*resend.EmailAttachment, error) {
+	m.record("GetAttachment", emailId, attachmentId)
+	if m.GetAttachmentFunc == nil {
+		var r0 *resend.EmailAttachment
+		return r0, notConfigured("Emails.GetAttachment")
+	}
+	return m.GetAttachmentFunc(emailId, attachmentId)
+}
+
+// ListAttachmentsWithOptions records the call and invokes ListAttachmentsWithOptionsFunc.
+func (m *EmailsMock) ListAttachmentsWithOptions(ctx context.Context, emailId string, options *resend.ListOptions) (resend.ListEmailAttachmentsResponse, error) {
+	m.record("ListAttachmentsWithOptions", ctx, emailId, options)
+	if m.ListAttachmentsWithOptionsFunc == nil {
+		var r0 resend.ListEmailAttachmentsResponse
+		return r0, notConfigured("Emails.ListAttachmentsWithOptions")
+	}
+	return m.ListAttachmentsWithOptionsFunc(ctx, emailId, options)
+}
+
+// ListAttachmentsWithContext records the call and invokes ListAttachmentsWithContextFunc.
+func (m *EmailsMock) ListAttachmentsWithContext(ctx context.Context, emailId string) (resend.ListEmailAttachmentsResponse, error) {
+	m.record("ListAttachmentsWithContext", ctx, emailId)
+	if m.ListAttachmentsWithContextFunc == nil {
+		var r0 resend.ListEmailAttachmentsResponse
+		return r0, notConfigured("Emails.ListAttachmentsWithContext")
+	}
+	return m.ListAttachmentsWithContextFunc(ctx, emailId)
+}
+
+// ListAttachments records the call and invokes ListAttachmentsFunc.
+func (m *EmailsMock) ListAttachments(emailId string) (resend.ListEmailAttachmentsResponse, error) {
+	m.record("ListAttachments", emailId)
+	if m.ListAttachmentsFunc == nil {
+		var r0 resend.ListEmailAttachmentsResponse
+		return r0, notConfigured("Emails.ListAttachments")
+	}
+	return m.ListAttachmentsFunc(emailId)
+}
+
+// AllAttachments records the call and invokes AllAttachmentsFunc.
+func (m *EmailsMock) AllAttachments(ctx context.Context, emailId string, options *resend.ListOptions) iter.Seq2[resend.EmailAttachment, error] {
+	m.record("AllAttachments", ctx, emailId, options)
+	if m.AllAttachmentsFunc == nil {
+		return notConfiguredSeq[resend.EmailAttachment](notConfigured("Emails.AllAttachments"))
+	}
+	return m.AllAttachmentsFunc(ctx, emailId, options)
+}
+
+// ReceivingMock is a programmable fake of resend.ReceivingSvc.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type ReceivingMock struct {
+	Recorder
+
+	GetWithOptionsFunc             func(ctx context.Context, emailId string, params *resend.GetReceivedEmailParams) (*resend.ReceivedEmail, error)
+	GetWithContextFunc             func(ctx context.Context, emailId string) (*resend.ReceivedEmail, error)
+	GetFunc                        func(emailId string) (*resend.ReceivedEmail, error)
+	ListWithOptionsFunc            func(ctx context.Context, options *resend.ListOptions) (resend.ListReceivedEmailsResponse, error)
+	ListWithContextFunc            func(ctx context.Context) (resend.ListReceivedEmailsResponse, error)
+	ListFunc                       func() (resend.ListReceivedEmailsResponse, error)
+	AllFunc                        func(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.ListReceivedEmail, error]
+	GetAttachmentWithContextFunc   func(ctx context.Context, emailId string, attachmentId string) (*resend.EmailAttachment, error)
+	GetAttachmentFunc              func(emailId string, attachmentId string) (*resend.EmailAttachment, error)
+	ListAttachmentsWithOptionsFunc func(ctx context.Context, emailId string, options *resend.ListOptions) (resend.ListEmailAttachmentsResponse, error)
+	ListAttachmentsWithContextFunc func(ctx context.Context, emailId string) (resend.ListEmailAttachmentsResponse, error)
+	ListAttachmentsFunc            func(emailId string) (resend.ListEmailAttachmentsResponse, error)
+	AllAttachmentsFunc             func(ctx context.Context, emailId string, options *resend.ListOptions) iter.Seq2[resend.EmailAttachment, error]
+}
+
+var _ resend.ReceivingSvc = (*ReceivingMock)(nil)
+
+// GetWithOptions records the call and invokes GetWithOptionsFunc.
+func (m *ReceivingMock) GetWithOptions(ctx context.Context, emailId string, params *resend.GetReceivedEmailParams) (*resend.ReceivedEmail, error) {
+	m.record("GetWithOptions", ctx, emailId, params)
+	if m.GetWithOptionsFunc == nil {
+		var r0 *resend.ReceivedEmail
+		return r0, notConfigured("Receiving.GetWithOptions")
+	}
+	return m.GetWithOptionsFunc(ctx, emailId, params)
+}
+
+// GetWithContext records the call and invokes GetWithContextFunc.
+func (m *ReceivingMock) GetWithContext(ctx context.Context, emailId string) (*resend.ReceivedEmail, error) {
+	m.record("GetWithContext", ctx, emailId)
+	if m.GetWithContextFunc == nil {
+		var r0 *resend.ReceivedEmail
+		return r0, notConfigured("Receiving.GetWithContext")
+	}
+	return m.GetWithContextFunc(ctx, emailId)
+}
+
+// Get records the call and invokes GetFunc.
+func (m *ReceivingMock) Get(emailId string) (*resend.ReceivedEmail, error) {
+	m.record("Get", emailId)
+	if m.GetFunc == nil {
+		var r0 *resend.ReceivedEmail
+		return r0, notConfigured("Receiving.Get")
+	}
+	return m.GetFunc(emailId)
+}
+
+// ListWithOptions records the call and invokes ListWithOptionsFunc.
+func (m *ReceivingMock) ListWithOptions(ctx context.Context, options *resend.ListOptions) (resend.ListReceivedEmailsResponse, error) {
+	m.record("ListWithOptions", ctx, options)
+	if m.ListWithOptionsFunc == nil {
+		var r0 resend.ListReceivedEmailsResponse
+		return r0, notConfigured("Receiving.ListWithOptions")
+	}
+	return m.ListWithOptionsFunc(ctx, options)
+}
+
+// ListWithContext records the call and invokes ListWithContextFunc.
+func (m *ReceivingMock) ListWithContext(ctx context.Context) (resend.ListReceivedEmailsResponse, error) {
+	m.record("ListWithContext", ctx)
+	if m.ListWithContextFunc == nil {
+		var r0 resend.ListReceivedEmailsResponse
+		return r0, notConfigured("Receiving.ListWithContext")
+	}
+	return m.ListWithContextFunc(ctx)
+}
+
+// List records the call and invokes ListFunc.
+func (m *ReceivingMock) List() (resend.ListReceivedEmailsResponse, error) {
+	m.record("List")
+	if m.ListFunc == nil {
+		var r0 resend.ListReceivedEmailsResponse
+		return r0, notConfigured("Receiving.List")
+	}
+	return m.ListFunc()
+}
+
+// All records the call and invokes AllFunc.
+func (m *ReceivingMock) All(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.ListReceivedEmail, error] {
+	m.record("All", ctx, options)
+	if m.AllFunc == nil {
+		return notConfiguredSeq[resend.ListReceivedEmail](notConfigured("Receiving.All"))
+	}
+	return m.AllFunc(ctx, options)
+}
+
+// GetAttachmentWithContext records the call and invokes GetAttachmentWithContextFunc.
+func (m *ReceivingMock) GetAttachmentWithContext(ctx context.Context, emailId string, attachmentId string) (*resend.EmailAttachment, error) {
+	m.record("GetAttachmentWithContext", ctx, emailId, attachmentId)
+	if m.GetAttachmentWithContextFunc == nil {
+		var r0 *resend.EmailAttachment
+		return r0, notConfigured("Receiving.GetAttachmentWithContext")
+	}
+	return m.GetAttachmentWithContextFunc(ctx, emailId, attachmentId)
+}
+
+// GetAttachment records the call and invokes GetAttachmentFunc.
+func (m *ReceivingMock) GetAttachment(emailId string, attachmentId string) (*resend.EmailAttachment, error) {
+	m.record("GetAttachment", emailId, attachmentId)
+	if m.GetAttachmentFunc == nil {
+		var r0 *resend.EmailAttachment
+		return r0, notConfigured("Receiving.GetAttachment")
+	}
+	return m.GetAttachmentFunc(emailId, attachmentId)
+}
+
+// ListAttachmentsWithOptions records the call and invokes ListAttachmentsWithOptionsFunc.
+func (m *ReceivingMock) ListAttachmentsWithOptions(ctx context.Context, emailId string, options *resend.ListOptions) (resend.ListEmailAttachmentsResponse, error) {
+	m.record("ListAttachmentsWithOptions", ctx, emailId, options)
+	if m.ListAttachmentsWithOptionsFunc == nil {
+		var r0 resend.ListEmailAttachmentsResponse
+		return r0, notConfigured("Receiving.ListAttachmentsWithOptions")
+	}
+	return m.ListAttachmentsWithOptionsFunc(ctx, emailId, options)
+}
+
+// ListAttachmentsWithContext records the call and invokes ListAttachmentsWithContextFunc.
+func (m *ReceivingMock) ListAttachmentsWithContext(ctx context.Context, emailId string) (resend.ListEmailAttachmentsResponse, error) {
+	m.record("ListAttachmentsWithContext", ctx, emailId)
+	if m.ListAttachmentsWithContextFunc == nil {
+		var r0 resend.ListEmailAttachmentsResponse
+		return r0, notConfigured("Receiving.ListAttachmentsWithContext")
+	}
+	return m.ListAttachmentsWithContextFunc(ctx, emailId)
+}
+
+// ListAttachments records the call and invokes ListAttachmentsFunc.
+func (m *ReceivingMock) ListAttachments(emailId string) (resend.ListEmailAttachmentsResponse, error) {
+	m.record("ListAttachments", emailId)
+	if m.ListAttachmentsFunc == nil {
+		var r0 resend.ListEmailAttachmentsResponse
+		return r0, notConfigured("Receiving.ListAttachments")
+	}
+	return m.ListAttachmentsFunc(emailId)
+}
+
+// AllAttachments records the call and invokes AllAttachmentsFunc.
+func (m *ReceivingMock) AllAttachments(ctx context.Context, emailId string, options *resend.ListOptions) iter.Seq2[resend.EmailAttachment, error] {
+	m.record("AllAttachments", ctx, emailId, options)
+	if m.AllAttachmentsFunc == nil {
+		return notConfiguredSeq[resend.EmailAttachment](notConfigured("Receiving.AllAttachments"))
+	}
+	return m.AllAttachmentsFunc(ctx, emailId, options)
+}
+
+// BatchMock is a programmable fake of resend.BatchSvc.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type BatchMock struct {
+	Recorder
+
+	SendFunc            func([]*resend.SendEmailRequest) (*resend.BatchEmailResponse, error)
+	SendWithContextFunc func(ctx context.Context, params []*resend.SendEmailRequest) (*resend.BatchEmailResponse, error)
+	SendWithOptionsFunc func(ctx context.Context, params []*resend.SendEmailRequest, options *resend.BatchSendEmailOptions) (*resend.BatchEmailResponse, error)
+}
+
+var _ resend.BatchSvc = (*BatchMock)(nil)
+
+// Send records the call and invokes SendFunc.
+func (m *BatchMock) Send(p0 []*resend.SendEmailRequest) (*resend.BatchEmailResponse, error) {
+	m.record("Send", p0)
+	if m.SendFunc == nil {
+		var r0 *resend.BatchEmailResponse
+		return r0, notConfigured("Batch.Send")
+	}
+	return m.SendFunc(p0)
+}
+
+// SendWithContext records the call and invokes SendWithContextFunc.
+func (m *BatchMock) SendWithContext(ctx context.Context, params []*resend.SendEmailRequest) (*resend.BatchEmailResponse, error) {
+	m.record("SendWithContext", ctx, params)
+	if m.SendWithContextFunc == nil {
+		var r0 *resend.BatchEmailResponse
+		return r0, notConfigured("Batch.SendWithContext")
+	}
+	return m.SendWithContextFunc(ctx, params)
+}
+
+// SendWithOptions records the call and invokes SendWithOptionsFunc.
+func (m *BatchMock) SendWithOptions(ctx context.Context, params []*resend.SendEmailRequest, options *resend.BatchSendEmailOptions) (*resend.BatchEmailResponse, error) {
+	m.record("SendWithOptions", ctx, params, options)
+	if m.SendWithOptionsFunc == nil {
+		var r0 *resend.BatchEmailResponse
+		return r0, notConfigured("Batch.SendWithOptions")
+	}
+	return m.SendWithOptionsFunc(ctx, params, options)
+}
+
+// ApiKeysMock is a programmable fake of resend.ApiKeysSvc.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type ApiKeysMock struct {
+	Recorder
+
+	CreateWithContextFunc func(ctx context.Context, params *resend.CreateApiKeyRequest) (resend.CreateApiKeyResponse, error)
+	CreateFunc            func(params *resend.CreateApiKeyRequest) (resend.CreateApiKeyResponse, error)
+	ListWithOptionsFunc   func(ctx context.Context, options *resend.ListOptions) (resend.ListApiKeysResponse, error)
+	ListWithContextFunc   func(ctx context.Context) (resend.ListApiKeysResponse, error)
+	ListFunc              func() (resend.ListApiKeysResponse, error)
+	AllFunc               func(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.ApiKey, error]
+	RemoveWithContextFunc func(ctx context.Context, apiKeyId string) (bool, error)
+	RemoveFunc            func(apiKeyId string) (bool, error)
+	UpdateWithContextFunc func(ctx context.Context, apiKeyId string, params *resend.UpdateApiKeyRequest) (resend.UpdateApiKeyResponse, error)
+	UpdateFunc            func(apiKeyId string, params *resend.UpdateApiKeyRequest) (resend.UpdateApiKeyResponse, error)
+}
+
+var _ resend.ApiKeysSvc = (*ApiKeysMock)(nil)
+
+// CreateWithContext records the call and invokes CreateWithContextFunc.
+func (m *ApiKeysMock) CreateWithContext(ctx context.Context, params *resend.CreateApiKeyRequest) (resend.CreateApiKeyResponse, error) {
+	m.record("CreateWithContext", ctx, params)
+	if m.CreateWithContextFunc == nil {
+		var r0 resend.CreateApiKeyResponse
+		return r0, notConfigured("ApiKeys.CreateWithContext")
+	}
+	return m.CreateWithContextFunc(ctx, params)
+}
+
+// Create records the call and invokes CreateFunc.
+func (m *ApiKeysMock) Create(params *resend.CreateApiKeyRequest) (resend.CreateApiKeyResponse, error) {
+	m.record("Create", params)
+	if m.CreateFunc == nil {
+		var r0 resend.CreateApiKeyResponse
+		return r0, notConfigured("ApiKeys.Create")
+	}
+	return m.CreateFunc(params)
+}
+
+// ListWithOptions records the call and invokes ListWithOptionsFunc.
+func (m *ApiKeysMock) ListWithOptions(ctx context.Context, options *resend.ListOptions) (resend.ListApiKeysResponse, error) {
+	m.record("ListWithOptions", ctx, options)
+	if m.ListWithOptionsFunc == nil {
+		var r0 resend.ListApiKeysResponse
+		return r0, notConfigured("ApiKeys.ListWithOptions")
+	}
+	return m.ListWithOptionsFunc(ctx, options)
+}
+
+// ListWithContext records the call and invokes ListWithContextFunc.
+func (m *ApiKeysMock) ListWithContext(ctx context.Context) (resend.ListApiKeysResponse, error) {
+	m.record("ListWithContext", ctx)
+	if m.ListWithContextFunc == nil {
+		var r0 resend.ListApiKeysResponse
+		return r0, notConfigured("ApiKeys.ListWithContext")
+	}
+	return m.ListWithContextFunc(ctx)
+}
+
+// List records the call and invokes ListFunc.
+func (m *ApiKeysMock) List() (resend.ListApiKeysResponse, error) {
+	m.record("List")
+	if m.ListFunc == nil {
+		var r0 resend.ListApiKeysResponse
+		return r0, notConfigured("ApiKeys.List")
+	}
+	return m.ListFunc()
+}
+
+// All records the call and invokes AllFunc.
+func (m *ApiKeysMock) All(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.ApiKey, error] {
+	m.record("All", ctx, options)
+	if m.AllFunc == nil {
+		return notConfiguredSeq[resend.ApiKey](notConfigured("ApiKeys.All"))
+	}
+	return m.AllFunc(ctx, options)
+}
+
+// RemoveWithContext records the call and invokes RemoveWithContextFunc.
+func (m *ApiKeysMock) RemoveWithContext(ctx context.Context, apiKeyId string) (bool, error) {
+	m.record("RemoveWithContext", ctx, apiKeyId)
+	if m.RemoveWithContextFunc == nil {
+		var r0 bool
+		return r0, notConfigured("ApiKeys.RemoveWithContext")
+	}
+	return m.RemoveWithContextFunc(ctx, apiKeyId)
+}
+
+// Remove records the call and invokes RemoveFunc.
+func (m *ApiKeysMock) Remove(apiKeyId string) (bool, error) {
+	m.record("Remove", apiKeyId)
+	if m.RemoveFunc == nil {
+		var r0 bool
+		return r0, notConfigured("ApiKeys.Remove")
+	}
+	return m.RemoveFunc(apiKeyId)
+}
+
+// UpdateWithContext records the call and invokes UpdateWithContextFunc.
+func (m *ApiKeysMock) UpdateWithContext(ctx context.Context, apiKeyId string, params *resend.UpdateApiKeyRequest) (resend.UpdateApiKeyResponse, error) {
+	m.record("UpdateWithContext", ctx, apiKeyId, params)
+	if m.UpdateWithContextFunc == nil {
+		var r0 resend.UpdateApiKeyResponse
+		return r0, notConfigured("ApiKeys.UpdateWithContext")
+	}
+	return m.UpdateWithContextFunc(ctx, apiKeyId, params)
+}
+
+// Update records the call and invokes UpdateFunc.
+func (m *ApiKeysMock) Update(apiKeyId string, params *resend.UpdateApiKeyRequest) (resend.UpdateApiKeyResponse, error) {
+	m.record("Update", apiKeyId, params)
+	if m.UpdateFunc == nil {
+		var r0 resend.UpdateApiKeyResponse
+		return r0, notConfigured("ApiKeys.Update")
+	}
+	return m.UpdateFunc(apiKeyId, params)
+}
+
+// DomainsMock is a programmable fake of resend.DomainsSvc.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type DomainsMock struct {
+	Recorder
+
+	CreateWithContextFunc func(ctx context.Context, params *resend.CreateDomainRequest) (resend.CreateDomainResponse, error)
+	CreateFunc            func(params *resend.CreateDomainRequest) (resend.CreateDomainResponse, error)
+	VerifyWithContextFunc func(ctx context.Context, domainId string) (bool, error)
+	VerifyFunc            func(domainId string) (bool, error)
+	ListWithOptionsFunc   func(ctx context.Context, options *resend.ListOptions) (resend.ListDomainsResponse, error)
+	ListWithContextFunc   func(ctx context.Context) (resend.ListDomainsResponse, error)
+	ListFunc              func() (resend.ListDomainsResponse, error)
+	AllFunc               func(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.Domain, error]
+	GetWithContextFunc    func(ctx context.Context, domainId string) (resend.Domain, error)
+	GetFunc               func(domainId string) (resend.Domain, error)
+	RemoveWithContextFunc func(ctx context.Context, domainId string) (bool, error)
+	RemoveFunc            func(domainId string) (bool, error)
+	UpdateWithContextFunc func(ctx context.Context, domainId string, params *resend.UpdateDomainRequest) (resend.Domain, error)
+	UpdateFunc            func(domainId string, params *resend.UpdateDomainRequest) (resend.Domain, error)
+}
+
+var _ resend.DomainsSvc = (*DomainsMock)(nil)
+
+// CreateWithContext records the call and invokes CreateWithContextFunc.
+func (m *DomainsMock) CreateWithContext(ctx context.Context, params *resend.CreateDomainRequest) (resend.CreateDomainResponse, error) {
+	m.record("CreateWithContext", ctx, params)
+	if m.CreateWithContextFunc == nil {
+		var r0 resend.CreateDomainResponse
+		return r0, notConfigured("Domains.CreateWithContext")
+	}
+	return m.CreateWithContextFunc(ctx, params)
+}
+
+// Create records the call and invokes CreateFunc.
+func (m *DomainsMock) Create(params *resend.CreateDomainRequest) (resend.CreateDomainResponse, error) {
+	m.record("Create", params)
+	if m.CreateFunc == nil {
+		var r0 resend.CreateDomainResponse
+		return r0, notConfigured("Domains.Create")
+	}
+	return m.CreateFunc(params)
+}
+
+// VerifyWithContext records the call and invokes VerifyWithContextFunc.
+func (m *DomainsMock) VerifyWithContext(ctx context.Context, domainId string) (bool, error) {
+	m.record("VerifyWithContext", ctx, domainId)
+	if m.VerifyWithContextFunc == nil {
+		var r0 bool
+		return r0, notConfigured("Domains.VerifyWithContext")
+	}
+	return m.VerifyWithContextFunc(ctx, domainId)
+}
+
+// Verify records the call and invokes VerifyFunc.
+func (m *DomainsMock) Verify(domainId string) (bool, error) {
+	m.record("Verify", domainId)
+	if m.VerifyFunc == nil {
+		var r0 bool
+		return r0, notConfigured("Domains.Verify")
+	}
+	return m.VerifyFunc(domainId)
+}
+
+// ListWithOptions records the call and invokes ListWithOptionsFunc.
+func (m *DomainsMock) ListWithOptions(ctx context.Context, options *resend.ListOptions) (resend.ListDomainsResponse, error) {
+	m.record("ListWithOptions", ctx, options)
+	if m.ListWithOptionsFunc == nil {
+		var r0 resend.ListDomainsResponse
+		return r0, notConfigured("Domains.ListWithOptions")
+	}
+	return m.ListWithOptionsFunc(ctx, options)
+}
+
+// ListWithContext records the call and invokes ListWithContextFunc.
+func (m *DomainsMock) ListWithContext(ctx context.Context) (resend.ListDomainsResponse, error) {
+	m.record("ListWithContext", ctx)
+	if m.ListWithContextFunc == nil {
+		var r0 resend.ListDomainsResponse
+		return r0, notConfigured("Domains.ListWithContext")
+	}
+	return m.ListWithContextFunc(ctx)
+}
+
+// List records the call and invokes ListFunc.
+func (m *DomainsMock) List() (resend.ListDomainsResponse, error) {
+	m.record("List")
+	if m.ListFunc == nil {
+		var r0 resend.ListDomainsResponse
+		return r0, notConfigured("Domains.List")
+	}
+	return m.ListFunc()
+}
+
+// All records the call and invokes AllFunc.
+func (m *DomainsMock) All(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.Domain, error] {
+	m.record("All", ctx, options)
+	if m.AllFunc == nil {
+		return notConfiguredSeq[resend.Domain](notConfigured("Domains.All"))
+	}
+	return m.AllFunc(ctx, options)
+}
+
+// GetWithContext records the call and invokes GetWithContextFunc.
+func (m *DomainsMock) GetWithContext(ctx context.Context, domainId string) (resend.Domain, error) {
+	m.record("GetWithContext", ctx, domainId)
+	if m.GetWithContextFunc == nil {
+		var r0 resend.Domain
+		return r0, notConfigured("Domains.GetWithContext")
+	}
+	return m.GetWithContextFunc(ctx, domainId)
+}
+
+// Get records the call and invokes GetFunc.
+func (m *DomainsMock) Get(domainId string) (resend.Domain, error) {
+	m.record("Get", domainId)
+	if m.GetFunc == nil {
+		var r0 resend.Domain
+		return r0, notConfigured("Domains.Get")
+	}
+	return m.GetFunc(domainId)
+}
+
+// RemoveWithContext records the call and invokes RemoveWithContextFunc.
+func (m *DomainsMock) RemoveWithContext(ctx context.Context, domainId string) (bool, error) {
+	m.record("RemoveWithContext", ctx, domainId)
+	if m.RemoveWithContextFunc == nil {
+		var r0 bool
+		return r0, notConfigured("Domains.RemoveWithContext")
+	}
+	return m.RemoveWithContextFunc(ctx, domainId)
+}
+
+// Remove records the call and invokes RemoveFunc.
+func (m *DomainsMock) Remove(domainId string) (bool, error) {
+	m.record("Remove", domainId)
+	if m.RemoveFunc == nil {
+		var r0 bool
+		return r0, notConfigured("Domains.Remove")
+	}
+	return m.RemoveFunc(domainId)
+}
+
+// UpdateWithContext records the call and invokes UpdateWithContextFunc.
+func (m *DomainsMock) UpdateWithContext(ctx context.Context, domainId string, params *resend.UpdateDomainRequest) (resend.Domain, error) {
+	m.record("UpdateWithContext", ctx, domainId, params)
+	if m.UpdateWithContextFunc == nil {
+		var r0 resend.Domain
+		return r0, notConfigured("Domains.UpdateWithContext")
+	}
+	return m.UpdateWithContextFunc(ctx, domainId, params)
+}
+
+// Update records the call and invokes UpdateFunc.
+func (m *DomainsMock) Update(domainId string, params *resend.UpdateDomainRequest) (resend.Domain, error) {
+	m.record("Update", domainId, params)
+	if m.UpdateFunc == nil {
+		var r0 resend.Domain
+		return r0, notConfigured("Domains.Update")
+	}
+	return m.UpdateFunc(domainId, params)
+}
+
+// DomainClaimsMock is a programmable fake of resend.DomainClaimsSvc.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type DomainClaimsMock struct {
+	Recorder
+
+	CreateFunc            func(params *resend.CreateDomainClaimRequest) (resend.DomainClaim, error)
+	CreateWithContextFunc func(ctx context.Context, params *resend.CreateDomainClaimRequest) (resend.DomainClaim, error)
+	GetFunc               func(domainId string) (resend.DomainClaim, error)
+	GetWithContextFunc    func(ctx context.Context, domainId string) (resend.DomainClaim, error)
+	VerifyFunc            func(domainId string) (resend.DomainClaim, error)
+	VerifyWithContextFunc func(ctx context.Context, domainId string) (resend.DomainClaim, error)
+}
+
+var _ resend.DomainClaimsSvc = (*DomainClaimsMock)(nil)
+
+// Create records the call and invokes CreateFunc.
+func (m *DomainClaimsMock) Create(params *resend.CreateDomainClaimRequest) (resend.DomainClaim, error) {
+	m.record("Create", params)
+	if m.CreateFunc == nil {
+		var r0 resend.DomainClaim
+		return r0, notConfigured("DomainClaims.Create")
+	}
+	return m.CreateFunc(params)
+}
+
+// CreateWithContext records the call and invokes CreateWithContextFunc.
+func (m *DomainClaimsMock) CreateWithContext(ctx context.Context, params *resend.CreateDomainClaimRequest) (resend.DomainClaim, error) {
+	m.record("CreateWithContext", ctx, params)
+	if m.CreateWithContextFunc == nil {
+		var r0 resend.DomainClaim
+		return r0, notConfigured("DomainClaims.CreateWithContext")
+	}
+	return m.CreateWithContextFunc(ctx, params)
+}
+
+// Get records the call and invokes GetFunc.
+func (m *DomainClaimsMock) Get(domainId string) (resend.DomainClaim, error) {
+	m.record("Get", domainId)
+	if m.GetFunc == nil {
+		var r0 resend.DomainClaim
+		return r0, notConfigured("DomainClaims.Get")
+	}
+	return m.GetFunc(domainId)
+}
+
+// GetWithContext records the call and invokes GetWithContextFunc.
+func (m *DomainClaimsMock) GetWithContext(ctx context.Context, domainId string) (resend.DomainClaim, error) {
+	m.record("GetWithContext", ctx, domainId)
+	if m.GetWithContextFunc == nil {
+		var r0 resend.DomainClaim
+		return r0, notConfigured("DomainClaims.GetWithContext")
+	}
+	return m.GetWithContextFunc(ctx, domainId)
+}
+
+// Verify records the call and invokes VerifyFunc.
+func (m *DomainClaimsMock) Verify(domainId string) (resend.DomainClaim, error) {
+	m.record("Verify", domainId)
+	if m.VerifyFunc == nil {
+		var r0 resend.DomainClaim
+		return r0, notConfigured("DomainClaims.Verify")
+	}
+	return m.VerifyFunc(domainId)
+}
+
+// VerifyWithContext records the call and invokes VerifyWithContextFunc.
+func (m *DomainClaimsMock) VerifyWithContext(ctx context.Context, domainId string) (resend.DomainClaim, error) {
+	m.record("VerifyWithContext", ctx, domainId)
+	if m.VerifyWithContextFunc == nil {
+		var r0 resend.DomainClaim
+		return r0, notConfigured("DomainClaims.VerifyWithContext")
+	}
+	return m.VerifyWithContextFunc(ctx, domainId)
+}
+
+// SegmentsMock is a programmable fake of resend.SegmentsSvc.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type SegmentsMock struct {
+	Recorder
+
+	CreateWithContextFunc func(ctx context.Context, params *resend.CreateSegmentRequest) (resend.CreateSegmentResponse, error)
+	CreateFunc            func(params *resend.CreateSegmentRequest) (resend.CreateSegmentResponse, error)
+	ListWithOptionsFunc   func(ctx context.Context, options *resend.ListOptions) (resend.ListSegmentsResponse, error)
+	ListWithContextFunc   func(ctx context.Context) (resend.ListSegmentsResponse, error)
+	ListFunc              func() (resend.ListSegmentsResponse, error)
+	AllFunc               func(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.Segment, error]
+	GetWithContextFunc    func(ctx context.Context, segmentId string) (resend.Segment, error)
+	GetFunc               func(segmentId string) (resend.Segment, error)
+	RemoveWithContextFunc func(ctx context.Context, segmentId string) (resend.RemoveSegmentResponse, error)
+	RemoveFunc            func(segmentId string) (resend.RemoveSegmentResponse, error)
+}
+
+var _ resend.SegmentsSvc = (*SegmentsMock)(nil)
+
+// CreateWithContext records the call and invokes CreateWithContextFunc.
+func (m *SegmentsMock) CreateWithContext(ctx context.Context, params *resend.CreateSegmentRequest) (resend.CreateSegmentResponse, error) {
+	m.record("CreateWithContext", ctx, params)
+	if m.CreateWithContextFunc == nil {
+		var r0 resend.CreateSegmentResponse
+		return r0, notConfigured("Segments.CreateWithContext")
+	}
+	return m.CreateWithContextFunc(ctx, params)
+}
+
+// Create records the call and invokes CreateFunc.
+func (m *SegmentsMock) Create(params *resend.CreateSegmentRequest) (resend.CreateSegmentResponse, error) {
+	m.record("Create", params)
+	if m.CreateFunc == nil {
+		var r0 resend.CreateSegmentResponse
+		return r0, notConfigured("Segments.Create")
+	}
+	return m.CreateFunc(params)
+}
+
+// ListWithOptions records the call and invokes ListWithOptionsFunc.
+func (m *SegmentsMock) ListWithOptions(ctx context.Context, options *resend.ListOptions) (resend.ListSegmentsResponse, error) {
+	m.record("ListWithOptions", ctx, options)
+	if m.ListWithOptionsFunc == nil {
+		var r0 resend.ListSegmentsResponse
+		return r0, notConfigured("Segments.ListWithOptions")
+	}
+	return m.ListWithOptionsFunc(ctx, options)
+}
+
+// ListWithContext records the call and invokes ListWithContextFunc.
+func (m *SegmentsMock) ListWithContext(ctx context.Context) (resend.ListSegmentsResponse, error) {
+	m.record("ListWithContext", ctx)
+	if m.ListWithContextFunc == nil {
+		var r0 resend.ListSegmentsResponse
+		return r0, notConfigured("Segments.ListWithContext")
+	}
+	return m.ListWithContextFunc(ctx)
+}
+
+// List records the call and invokes ListFunc.
+func (m *SegmentsMock) List() (resend.ListSegmentsResponse, error) {
+	m.record("List")
+	if m.ListFunc == nil {
+		var r0 resend.ListSegmentsResponse
+		return r0, notConfigured("Segments.List")
+	}
+	return m.ListFunc()
+}
+
+// All records the call and invokes AllFunc.
+func (m *SegmentsMock) All(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.Segment, error] {
+	m.record("All", ctx, options)
+	if m.AllFunc == nil {
+		return notConfiguredSeq[resend.Segment](notConfigured("Segments.All"))
+	}
+	return m.AllFunc(ctx, options)
+}
+
+// GetWithContext records the call and invokes GetWithContextFunc.
+func (m *SegmentsMock) GetWithContext(ctx context.Context, segmentId string) (resend.Segment, error) {
+	m.record("GetWithContext", ctx, segmentId)
+	if m.GetWithContextFunc == nil {
+		var r0 resend.Segment
+		return r0, notConfigured("Segments.GetWithContext")
+	}
+	return m.GetWithContextFunc(ctx, segmentId)
+}
+
+// Get records the call and invokes GetFunc.
+func (m *SegmentsMock) Get(segmentId string) (resend.Segment, error) {
+	m.record("Get", segmentId)
+	if m.GetFunc == nil {
+		var r0 resend.Segment
+		return r0, notConfigured("Segments.Get")
+	}
+	return m.GetFunc(segmentId)
+}
+
+// RemoveWithContext records the call and invokes RemoveWithContextFunc.
+func (m *SegmentsMock) RemoveWithContext(ctx context.Context, segmentId string) (resend.RemoveSegmentResponse, error) {
+	m.record("RemoveWithContext", ctx, segmentId)
+	if m.RemoveWithContextFunc == nil {
+		var r0 resend.RemoveSegmentResponse
+		return r0, notConfigured("Segments.RemoveWithContext")
+	}
+	return m.RemoveWithContextFunc(ctx, segmentId)
+}
+
+// Remove records the call and invokes RemoveFunc.
+func (m *SegmentsMock) Remove(segmentId string) (resend.RemoveSegmentResponse, error) {
+	m.record("Remove", segmentId)
+	if m.RemoveFunc == nil {
+		var r0 resend.RemoveSegmentResponse
+		return r0, notConfigured("Segments.Remove")
+	}
+	return m.RemoveFunc(segmentId)
+}
+
+// AudiencesMock is a programmable fake of resend.AudiencesSvc.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type AudiencesMock struct {
+	Recorder
+
+	CreateWithContextFunc func(ctx context.Context, params *resend.CreateAudienceRequest) (resend.CreateAudienceResponse, error)
+	CreateFunc            func(params *resend.CreateAudienceRequest) (resend.CreateAudienceResponse, error)
+	ListWithOptionsFunc   func(ctx context.Context, options *resend.ListOptions) (resend.ListAudiencesResponse, error)
+	ListWithContextFunc   func(ctx context.Context) (resend.ListAudiencesResponse, error)
+	ListFunc              func() (resend.ListAudiencesResponse, error)
+	GetWithContextFunc    func(ctx context.Context, audienceId string) (resend.Audience, error)
+	GetFunc               func(audienceId string) (resend.Audience, error)
+	RemoveWithContextFunc func(ctx context.Context, audienceId string) (resend.RemoveAudienceResponse, error)
+	RemoveFunc            func(audienceId string) (resend.RemoveAudienceResponse, error)
+}
+
+var _ resend.AudiencesSvc = (*AudiencesMock)(nil)
+
+// CreateWithContext records the call and invokes CreateWithContextFunc.
+func (m *AudiencesMock) CreateWithContext(ctx context.Context, params *resend.CreateAudienceRequest) (resend.CreateAudienceResponse, error) {
+	m.record("CreateWithContext", ctx, params)
+	if m.CreateWithContextFunc == nil {
+		var r0 resend.CreateAudienceResponse
+		return r0, notConfigured("Audiences.CreateWithContext")
+	}
+	return m.CreateWithContextFunc(ctx, params)
+}
+
+// Create records the call and invokes CreateFunc.
+func (m *AudiencesMock) Create(params *resend.CreateAudienceRequest) (resend.CreateAudienceResponse, error) {
+	m.record("Create", params)
+	if m.CreateFunc == nil {
+		var r0 resend.CreateAudienceResponse
+		return r0, notConfigured("Audiences.Create")
+	}
+	return m.CreateFunc(params)
+}
+
+// ListWithOptions records the call and invokes ListWithOptionsFunc.
+func (m *AudiencesMock) ListWithOptions(ctx context.Context, options *resend.ListOptions) (resend.ListAudiencesResponse, error) {
+	m.record("ListWithOptions", ctx, options)
+	if m.ListWithOptionsFunc == nil {
+		var r0 resend.ListAudiencesResponse
+		return r0, notConfigured("Audiences.ListWithOptions")
+	}
+	return m.ListWithOptionsFunc(ctx, options)
+}
+
+// ListWithContext records the call and invokes ListWithContextFunc.
+func (m *AudiencesMock) ListWithContext(ctx context.Context) (resend.ListAudiencesResponse, error) {
+	m.record("ListWithContext", ctx)
+	if m.ListWithContextFunc == nil {
+		var r0 resend.ListAudiencesResponse
+		return r0, notConfigured("Audiences.ListWithContext")
+	}
+	return m.ListWithContextFunc(ctx)
+}
+
+// List records the call and invokes ListFunc.
+func (m *AudiencesMock) List() (resend.ListAudiencesResponse, error) {
+	m.record("List")
+	if m.ListFunc == nil {
+		var r0 resend.ListAudiencesResponse
+		return r0, notConfigured("Audiences.List")
+	}
+	return m.ListFunc()
+}
+
+// GetWithContext records the call and invokes GetWithContextFunc.
+func (m *AudiencesMock) GetWithContext(ctx context.Context, audienceId string) (resend.Audience, error) {
+	m.record("GetWithContext", ctx, audienceId)
+	if m.GetWithContextFunc == nil {
+		var r0 resend.Audience
+		return r0, notConfigured("Audiences.GetWithContext")
+	}
+	return m.GetWithContextFunc(ctx, audienceId)
+}
+
+// Get records the call and invokes GetFunc.
+func (m *AudiencesMock) Get(audienceId string) (resend.Audience, error) {
+	m.record("Get", audienceId)
+	if m.GetFunc == nil {
+		var r0 resend.Audience
+		return r0, notConfigured("Audiences.Get")
+	}
+	return m.GetFunc(audienceId)
+}
+
+// RemoveWithContext records the call and invokes RemoveWithContextFunc.
+func (m *AudiencesMock) RemoveWithContext(ctx context.Context, audienceId string) (resend.RemoveAudienceResponse, error) {
+	m.record("RemoveWithContext", ctx, audienceId)
+	if m.RemoveWithContextFunc == nil {
+		var r0 resend.RemoveAudienceResponse
+		return r0, notConfigured("Audiences.RemoveWithContext")
+	}
+	return m.RemoveWithContextFunc(ctx, audienceId)
+}
+
+// Remove records the call and invokes RemoveFunc.
+func (m *AudiencesMock) Remove(audienceId string) (resend.RemoveAudienceResponse, error) {
+	m.record("Remove", audienceId)
+	if m.RemoveFunc == nil {
+		var r0 resend.RemoveAudienceResponse
+		return r0, notConfigured("Audiences.Remove")
+	}
+	return m.RemoveFunc(audienceId)
+}
+
+// ContactsMock is a programmable fake of resend.ContactsAPI.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type ContactsMock struct {
+	Recorder
+
+	ContactTopicsMock     *ContactTopicsMock
+	ContactSegmentsMock   *ContactSegmentsMock
+	ContactPropertiesMock *ContactPropertiesMock
+	ContactImportsMock    *ContactImportsMock
+
+	CreateFunc            func(params *resend.CreateContactRequest) (resend.CreateContactResponse, error)
+	CreateWithContextFunc func(ctx context.Context, params *resend.CreateContactRequest) (resend.CreateContactResponse, error)
+	GetFunc               func(options *resend.GetContactOptions) (resend.Contact, error)
+	GetWithContextFunc    func(ctx context.Context, options *resend.GetContactOptions) (resend.Contact, error)
+	ListFunc              func(options *resend.ListContactsOptions) (resend.ListContactsResponse, error)
+	ListWithContextFunc   func(ctx context.Context, options *resend.ListContactsOptions) (resend.ListContactsResponse, error)
+	AllFunc               func(ctx context.Context, options *resend.ListContactsOptions) iter.Seq2[resend.Contact, error]
+	UpdateFunc            func(params *resend.UpdateContactRequest) (resend.UpdateContactResponse, error)
+	UpdateWithContextFunc func(ctx context.Context, params *resend.UpdateContactRequest) (resend.UpdateContactResponse, error)
+	RemoveFunc            func(options *resend.RemoveContactOptions) (resend.RemoveContactResponse, error)
+	RemoveWithContextFunc func(ctx context.Context, options *resend.RemoveContactOptions) (resend.RemoveContactResponse, error)
+}
+
+var _ resend.ContactsAPI = (*ContactsMock)(nil)
+
+// Topics returns ContactTopicsMock, creating it on first use.
+func (m *ContactsMock) Topics() resend.ContactTopicsSvc {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.ContactTopicsMock == nil {
+		m.ContactTopicsMock = &ContactTopicsMock{}
+	}
+	return m.ContactTopicsMock
+}
+
+// Segments returns ContactSegmentsMock, creating it on first use.
+func (m *ContactsMock) Segments() resend.ContactSegmentsSvc {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.ContactSegmentsMock == nil {
+		m.ContactSegmentsMock = &ContactSegmentsMock{}
+	}
+	return m.ContactSegmentsMock
+}
+
+// Properties returns ContactPropertiesMock, creating it on first use.
+func (m *ContactsMock) Properties() resend.ContactPropertiesSvc {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.ContactPropertiesMock == nil {
+		m.ContactPropertiesMock = &ContactPropertiesMock{}
+	}
+	return m.ContactPropertiesMock
+}
+
+// Imports returns ContactImportsMock, creating it on first use.
+func (m *ContactsMock) Imports() resend.ContactImportsSvc {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.ContactImportsMock == nil {
+		m.ContactImportsMock = &ContactImportsMock{}
+	}
+	return m.ContactImportsMock
+}
+
+// Create records the call and invokes CreateFunc.
+func (m *ContactsMock) Create(params *resend.CreateContactRequest) (resend.CreateContactResponse, error) {
+	m.record("Create", params)
+	if m.CreateFunc == nil {
+		var r0 resend.CreateContactResponse
+		return r0, notConfigured("Contacts.Create")
+	}
+	return m.CreateFunc(params)
+}
+
+// CreateWithContext records the call and invokes CreateWithContextFunc.
+func (m *ContactsMock) CreateWithContext(ctx context.Context, params *resend.CreateContactRequest) (resend.CreateContactResponse, error) {
+	m.record("CreateWithContext", ctx, params)
+	if m.CreateWithContextFunc == nil {
+		var r0 resend.CreateContactResponse
+		return r0, notConfigured("Contacts.CreateWithContext")
+	}
+	return m.CreateWithContextFunc(ctx, params)
+}
+
+// Get records the call and invokes GetFunc.
+func (m *ContactsMock) Get(options *resend.GetContactOptions) (resend.Contact, error) {
+	m.record("Get", options)
+	if m.GetFunc == nil {
+		var r0 resend.Contact
+		return r0, notConfigured("Contacts.Get")
+	}
+	return m.GetFunc(options)
+}
+
+// GetWithContext records the call and invokes GetWithContextFunc.
+func (m *ContactsMock) GetWithContext(ctx context.Context, options *resend.GetContactOptions) (resend.Contact, error) {
+	m.record("GetWithContext", ctx, options)
+	if m.GetWithContextFunc == nil {
+		var r0 resend.Contact
+		return r0, notConfigured("Contacts.GetWithContext")
+	}
+	return m.GetWithContextFunc(ctx, options)
+}
+
+// List records the call and invokes ListFunc.
+func (m *ContactsMock) List(options *resend.ListContactsOptions) (resend.ListContactsResponse, error) {
+	m.record("List", options)
+	if m.ListFunc == nil {
+		var r0 resend.ListContactsResponse
+		return r0, notConfigured("Contacts.List")
+	}
+	return m.ListFunc(options)
+}
+
+// ListWithContext records the call and invokes ListWithContextFunc.
+func (m *ContactsMock) ListWithContext(ctx context.Context, options *resend.ListContactsOptions) (resend.ListContactsResponse, error) {
+	m.record("ListWithContext", ctx, options)
+	if m.ListWithContextFunc == nil {
+		var r0 resend.ListContactsResponse
+		return r0, notConfigured("Contacts.ListWithContext")
+	}
+	return m.ListWithContextFunc(ctx, options)
+}
+
+// All records the call and invokes AllFunc.
+func (m *ContactsMock) All(ctx context.Context, options *resend.ListContactsOptions) iter.Seq2[resend.Contact, error] {
+	m.record("All", ctx, options)
+	if m.AllFunc == nil {
+		return notConfiguredSeq[resend.Contact](notConfigured("Contacts.All"))
+	}
+	return m.AllFunc(ctx, options)
+}
+
+// Update records the call and invokes UpdateFunc.
+func (m *ContactsMock) Update(params *resend.UpdateContactRequest) (resend.UpdateContactResponse, error) {
+	m.record("Update", params)
+	if m.UpdateFunc == nil {
+		var r0 resend.UpdateContactResponse
+		return r0, notConfigured("Contacts.Update")
+	}
+	return m.UpdateFunc(params)
+}
+
+// UpdateWithContext records the call and invokes UpdateWithContextFunc.
+func (m *ContactsMock) UpdateWithContext(ctx context.Context, params *resend.UpdateContactRequest) (resend.UpdateContactResponse, error) {
+	m.record("UpdateWithContext", ctx, params)
+	if m.UpdateWithContextFunc == nil {
+		var r0 resend.UpdateContactResponse
+		return r0, notConfigured("Contacts.UpdateWithContext")
+	}
+	return m.UpdateWithContextFunc(ctx, params)
+}
+
+// Remove records the call and invokes RemoveFunc.
+func (m *ContactsMock) Remove(options *resend.RemoveContactOptions) (resend.RemoveContactResponse, error) {
+	m.record("Remove", options)
+	if m.RemoveFunc == nil {
+		var r0 resend.RemoveContactResponse
+		return r0, notConfigured("Contacts.Remove")
+	}
+	return m.RemoveFunc(options)
+}
+
+// RemoveWithContext records the call and invokes RemoveWithContextFunc.
+func (m *ContactsMock) RemoveWithContext(ctx context.Context, options *resend.RemoveContactOptions) (resend.RemoveContactResponse, error) {
+	m.record("RemoveWithContext", ctx, options)
+	if m.RemoveWithContextFunc == nil {
+		var r0 resend.RemoveContactResponse
+		return r0, notConfigured("Contacts.RemoveWithContext")
+	}
+	return m.RemoveWithContextFunc(ctx, options)
+}
+
+// ContactTopicsMock is a programmable fake of resend.ContactTopicsSvc.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type ContactTopicsMock struct {
+	Recorder
+
+	ListWithOptionsFunc   func(ctx context.Context, id string, options *resend.ListOptions) (resend.ListContactTopicsResponse, error)
+	ListWithContextFunc   func(ctx context.Context, id string) (resend.ListContactTopicsResponse, error)
+	ListFunc              func(id string) (resend.ListContactTopicsResponse, error)
+	AllFunc               func(ctx context.Context, id string, options *resend.ListOptions) iter.Seq2[resend.ContactTopic, error]
+	UpdateWithContextFunc func(ctx context.Context, params *resend.UpdateContactTopicsRequest) (resend.UpdateContactTopicsResponse, error)
+	UpdateFunc            func(params *resend.UpdateContactTopicsRequest) (resend.UpdateContactTopicsResponse, error)
+}
+
+var _ resend.ContactTopicsSvc = (*ContactTopicsMock)(nil)
+
+// ListWithOptions records the call and invokes ListWithOptionsFunc.
+func (m *ContactTopicsMock) ListWithOptions(ctx context.Context, id string, options *resend.ListOptions) (resend.ListContactTopicsResponse, error) {
+	m.record("ListWithOptions", ctx, id, options)
+	if m.ListWithOptionsFunc == nil {
+		var r0 resend.ListContactTopicsResponse
+		return r0, notConfigured("ContactTopics.ListWithOptions")
+	}
+	return m.ListWithOptionsFunc(ctx, id, options)
+}
+
+// ListWithContext records the call and invokes ListWithContextFunc.
+func (m *ContactTopicsMock) ListWithContext(ctx context.Context, id string) (resend.ListContactTopicsResponse, error) {
+	m.record("ListWithContext", ctx, id)
+	if m.ListWithContextFunc == nil {
+		var r0 resend.ListContactTopicsResponse
+		return r0, notConfigured("ContactTopics.ListWithContext")
+	}
+	return m.ListWithContextFunc(ctx, id)
+}
+
+// List records the call and invokes ListFunc.
+func (m *ContactTopicsMock) List(id string) (resend.ListContactTopicsResponse, error) {
+	m.record("List", id)
+	if m.ListFunc == nil {
+		var r0 resend.ListContactTopicsResponse
+		return r0, notConfigured("ContactTopics.List")
+	}
+	return m.ListFunc(id)
+}
+
+// All records the call and invokes AllFunc.
+func (m *ContactTopicsMock) All(ctx context.Context, id string, options *resend.ListOptions) iter.Seq2[resend.ContactTopic, error] {
+	m.record("All", ctx, id, options)
+	if m.AllFunc == nil {
+		return notConfiguredSeq[resend.ContactTopic](notConfigured("ContactTopics.All"))
+	}
+	return m.AllFunc(ctx, id, options)
+}
+
+// UpdateWithContext records the call and invokes UpdateWithContextFunc.
+func (m *ContactTopicsMock) UpdateWithContext(ctx context.Context, params *resend.UpdateContactTopicsRequest) (resend.UpdateContactTopicsResponse, error) {
+	m.record("UpdateWithContext", ctx, params)
+	if m.UpdateWithContextFunc == nil {
+		var r0 resend.UpdateContactTopicsResponse
+		return r0, notConfigured("ContactTopics.UpdateWithContext")
+	}
+	return m.UpdateWithContextFunc(ctx, params)
+}
+
+// Update records the call and invokes UpdateFunc.
+func (m *ContactTopicsMock) Update(params *resend.UpdateContactTopicsRequest) (resend.UpdateContactTopicsResponse, error) {
+	m.record("Update", params)
+	if m.UpdateFunc == nil {
+		var r0 resend.UpdateContactTopicsResponse
+		return r0, notConfigured("ContactTopics.Update")
+	}
+	return m.UpdateFunc(params)
+}
+
+// ContactSegmentsMock is a programmable fake of resend.ContactSegmentsSvc.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type ContactSegmentsMock struct {
+	Recorder
+
+	AddWithContextFunc    func(ctx context.Context, params *resend.AddContactSegmentRequest) (resend.AddContactSegmentResponse, error)
+	AddFunc               func(params *resend.AddContactSegmentRequest) (resend.AddContactSegmentResponse, error)
+	RemoveWithContextFunc func(ctx context.Context, params *resend.RemoveContactSegmentRequest) (resend.RemoveContactSegmentResponse, error)
+	RemoveFunc            func(params *resend.RemoveContactSegmentRequest) (resend.RemoveContactSegmentResponse, error)
+	ListWithOptionsFunc   func(ctx context.Context, params *resend.ListContactSegmentsRequest, options *resend.ListOptions) (resend.ListContactSegmentsResponse, error)
+	ListWithContextFunc   func(ctx context.Context, params *resend.ListContactSegmentsRequest) (resend.ListContactSegmentsResponse, error)
+	ListFunc              func(params *resend.ListContactSegmentsRequest) (resend.ListContactSegmentsResponse, error)
+	AllFunc               func(ctx context.Context, params *resend.ListContactSegmentsRequest, options *resend.ListOptions) iter.Seq2[resend.Segment, error]
+}
+
+var _ resend.ContactSegmentsSvc = (*ContactSegmentsMock)(nil)
+
+// AddWithContext records the call and invokes AddWithContextFunc.
+func (m *ContactSegmentsMock) AddWithContext(ctx context.Context, params *resend.AddContactSegmentRequest) (resend.AddContactSegmentResponse, error) {
+	m.record("AddWithContext", ctx, params)
+	if m.AddWithContextFunc == nil {
+		var r0 resend.AddContactSegmentResponse
+		return r0, notConfigured("ContactSegments.AddWithContext")
+	}
+	return m.AddWithContextFunc(ctx, params)
+}
+
+// Add records the call and invokes AddFunc.
+func (m *ContactSegmentsMock) Add(params *resend.AddContactSegmentRequest) (resend.AddContactSegmentResponse, error) {
+	m.record("Add", params)
+	if m.AddFunc == nil {
+		var r0 resend.AddContactSegmentResponse
+		return r0, notConfigured("ContactSegments.Add")
+	}
+	return m.AddFunc(params)
+}
+
+// RemoveWithContext records the call and invokes RemoveWithContextFunc.
+func (m *ContactSegmentsMock) RemoveWithContext(ctx context.Context, params *resend.RemoveContactSegmentRequest) (resend.RemoveContactSegmentResponse, error) {
+	m.record("RemoveWithContext", ctx, params)
+	if m.RemoveWithContextFunc == nil {
+		var r0 resend.RemoveContactSegmentResponse
+		return r0, notConfigured("ContactSegments.RemoveWithContext")
+	}
+	return m.RemoveWithContextFunc(ctx, params)
+}
+
+// Remove records the call and invokes RemoveFunc.
+func (m *ContactSegmentsMock) Remove(params *resend.RemoveContactSegmentRequest) (resend.RemoveContactSegmentResponse, error) {
+	m.record("Remove", params)
+	if m.RemoveFunc == nil {
+		var r0 resend.RemoveContactSegmentResponse
+		return r0, notConfigured("ContactSegments.Remove")
+	}
+	return m.RemoveFunc(params)
+}
+
+// ListWithOptions records the call and invokes ListWithOptionsFunc.
+func (m *ContactSegmentsMock) ListWithOptions(ctx context.Context, params *resend.ListContactSegmentsRequest, options *resend.ListOptions) (resend.ListContactSegmentsResponse, error) {
+	m.record("ListWithOptions", ctx, params, options)
+	if m.ListWithOptionsFunc == nil {
+		var r0 resend.ListContactSegmentsResponse
+		return r0, notConfigured("ContactSegments.ListWithOptions")
+	}
+	return m.ListWithOptionsFunc(ctx, params, options)
+}
+
+// ListWithContext records the call and invokes ListWithContextFunc.
+func (m *ContactSegmentsMock) ListWithContext(ctx context.Context, params *resend.ListContactSegmentsRequest) (resend.ListContactSegmentsResponse, error) {
+	m.record("ListWithContext", ctx, params)
+	if m.ListWithContextFunc == nil {
+		var r0 resend.ListContactSegmentsResponse
+		return r0, notConfigured("ContactSegments.ListWithContext")
+	}
+	return m.ListWithContextFunc(ctx, params)
+}
+
+// List records the call and invokes ListFunc.
+func (m *ContactSegmentsMock) List(params *resend.ListContactSegmentsRequest) (resend.ListContactSegmentsResponse, error) {
+	m.record("List", params)
+	if m.ListFunc == nil {
+		var r0 resend.ListContactSegmentsResponse
+		return r0, notConfigured("ContactSegments.List")
+	}
+	return m.ListFunc(params)
+}
+
+// All records the call and invokes AllFunc.
+func (m *ContactSegmentsMock) All(ctx context.Context, params *resend.ListContactSegmentsRequest, options *resend.ListOptions) iter.Seq2[resend.Segment, error] {
+	m.record("All", ctx, params, options)
+	if m.AllFunc == nil {
+		return notConfiguredSeq[resend.Segment](notConfigured("ContactSegments.All"))
+	}
+	return m.AllFunc(ctx, params, options)
+}
+
+// ContactPropertiesMock is a programmable fake of resend.ContactPropertiesSvc.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type ContactPropertiesMock struct {
+	Recorder
+
+	CreateWithContextFunc func(ctx context.Context, params *resend.CreateContactPropertyRequest) (resend.CreateContactPropertyResponse, error)
+	CreateFunc            func(params *resend.CreateContactPropertyRequest) (resend.CreateContactPropertyResponse, error)
+	ListWithOptionsFunc   func(ctx context.Context, options *resend.ListOptions) (resend.ListContactPropertiesResponse, error)
+	ListWithContextFunc   func(ctx context.Context) (resend.ListContactPropertiesResponse, error)
+	ListFunc              func() (resend.ListContactPropertiesResponse, error)
+	AllFunc               func(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.ContactProperty, error]
+	GetWithContextFunc    func(ctx context.Context, id string) (resend.ContactProperty, error)
+	GetFunc               func(id string) (resend.ContactProperty, error)
+	UpdateWithContextFunc func(ctx context.Context, params *resend.UpdateContactPropertyRequest) (resend.UpdateContactPropertyResponse, error)
+	UpdateFunc            func(params *resend.UpdateContactPropertyRequest) (resend.UpdateContactPropertyResponse, error)
+	RemoveWithContextFunc func(ctx context.Context, id string) (resend.RemoveContactPropertyResponse, error)
+	RemoveFunc            func(id string) (resend.RemoveContactPropertyResponse, error)
+}
+
+var _ resend.ContactPropertiesSvc = (*ContactPropertiesMock)(nil)
+
+// CreateWithContext records the call and invokes CreateWithContextFunc.
+func (m *ContactPropertiesMock) CreateWithContext(ctx context.Context, params *resend.CreateContactPropertyRequest) (resend.CreateContactPropertyResponse, error) {
+	m.record("CreateWithContext", ctx, params)
+	if m.CreateWithContextFunc == nil {
+		var r0 resend.CreateContactPropertyResponse
+		return r0, notConfigured("ContactProperties.CreateWithContext")
+	}
+	return m.CreateWithContextFunc(ctx, params)
+}
+
+// Create records the call and invokes CreateFunc.
+func (m *ContactPropertiesMock) Create(params *resend.CreateContactPropertyRequest) (resend.CreateContactPropertyResponse, error) {
+	m.record("Create", params)
+	if m.CreateFunc == nil {
+		var r0 resend.CreateContactPropertyResponse
+		return r0, notConfigured("ContactProperties.Create")
+	}
+	return m.CreateFunc(params)
+}
+
+// ListWithOptions records the call and invokes ListWithOptionsFunc.
+func (m *ContactPropertiesMock) ListWithOptions(ctx context.Context, options *resend.ListOptions) (resend.ListContactPropertiesResponse, error) {
+	m.record("ListWithOptions", ctx, options)
+	if m.ListWithOptionsFunc == nil {
+		var r0 resend.ListContactPropertiesResponse
+		return r0, notConfigured("ContactProperties.ListWithOptions")
+	}
+	return m.ListWithOptionsFunc(ctx, options)
+}
+
+// ListWithContext records the call and invokes ListWithContextFunc.
+func (m *ContactPropertiesMock) ListWithContext(ctx context.Context) (resend.ListContactPropertiesResponse, error) {
+	m.record("ListWithContext", ctx)
+	if m.ListWithContextFunc == nil {
+		var r0 resend.ListContactPropertiesResponse
+		return r0, notConfigured("ContactProperties.ListWithContext")
+	}
+	return m.ListWithContextFunc(ctx)
+}
+
+// List records the call and invokes ListFunc.
+func (m *ContactPropertiesMock) List() (resend.ListContactPropertiesResponse, error) {
+	m.record("List")
+	if m.ListFunc == nil {
+		var r0 resend.ListContactPropertiesResponse
+		return r0, notConfigured("ContactProperties.List")
+	}
+	return m.ListFunc()
+}
+
+// All records the call and invokes AllFunc.
+func (m *ContactPropertiesMock) All(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.ContactProperty, error] {
+	m.record("All", ctx, options)
+	if m.AllFunc == nil {
+		return notConfiguredSeq[resend.ContactProperty](notConfigured("ContactProperties.All"))
+	}
+	return m.AllFunc(ctx, options)
+}
+
+// GetWithContext records the call and invokes GetWithContextFunc.
+func (m *ContactPropertiesMock) GetWithContext(ctx context.Context, id string) (resend.ContactProperty, error) {
+	m.record("GetWithContext", ctx, id)
+	if m.GetWithContextFunc == nil {
+		var r0 resend.ContactProperty
+		return r0, notConfigured("ContactProperties.GetWithContext")
+	}
+	return m.GetWithContextFunc(ctx, id)
+}
+
+// Get records the call and invokes GetFunc.
+func (m *ContactPropertiesMock) Get(id string) (resend.ContactProperty, error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		var r0 resend.ContactProperty
+		return r0, notConfigured("ContactProperties.Get")
+	}
+	return m.GetFunc(id)
+}
+
+// UpdateWithContext records the call and invokes UpdateWithContextFunc.
+func (m *ContactPropertiesMock) UpdateWithContext(ctx context.Context, params *resend.UpdateContactPropertyRequest) (resend.UpdateContactPropertyResponse, error) {
+	m.record("UpdateWithContext", ctx, params)
+	if m.UpdateWithContextFunc == nil {
+		var r0 resend.UpdateContactPropertyResponse
+		return r0, notConfigured("ContactProperties.UpdateWithContext")
+	}
+	return m.UpdateWithContextFunc(ctx, params)
+}
+
+// Update records the call and invokes UpdateFunc.
+func (m *ContactPropertiesMock) Update(params *resend.UpdateContactPropertyRequest) (resend.UpdateContactPropertyResponse, error) {
+	m.record("Update", params)
+	if m.UpdateFunc == nil {
+		var r0 resend.UpdateContactPropertyResponse
+		return r0, notConfigured("ContactProperties.Update")
+	}
+	return m.UpdateFunc(params)
+}
+
+// RemoveWithContext records the call and invokes RemoveWithContextFunc.
+func (m *ContactPropertiesMock) RemoveWithContext(ctx context.Context, id string) (resend.RemoveContactPropertyResponse, error) {
+	m.record("RemoveWithContext", ctx, id)
+	if m.RemoveWithContextFunc == nil {
+		var r0 resend.RemoveContactPropertyResponse
+		return r0, notConfigured("ContactProperties.RemoveWithContext")
+	}
+	return m.RemoveWithContextFunc(ctx, id)
+}
+
+// Remove records the call and invokes RemoveFunc.
+func (m *ContactPropertiesMock) Remove(id string) (resend.RemoveContactPropertyResponse, error) {
+	m.record("Remove", id)
+	if m.RemoveFunc == nil {
+		var r0 resend.RemoveContactPropertyResponse
+		return r0, notConfigured("ContactProperties.Remove")
+	}
+	return m.RemoveFunc(id)
+}
+
+// ContactImportsMock is a programmable fake of resend.ContactImportsSvc.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type ContactImportsMock struct {
+	Recorder
+
+	CreateFunc            func(params *resend.CreateContactImportRequest) (resend.CreateContactImportResponse, error)
+	CreateWithContextFunc func(ctx context.Context, params *resend.CreateContactImportRequest) (resend.CreateContactImportResponse, error)
+	GetFunc               func(id string) (resend.ContactImport, error)
+	GetWithContextFunc    func(ctx context.Context, id string) (resend.ContactImport, error)
+	ListFunc              func(options *resend.ListContactImportsOptions) (resend.ListContactImportsResponse, error)
+	ListWithContextFunc   func(ctx context.Context, options *resend.ListContactImportsOptions) (resend.ListContactImportsResponse, error)
+	AllFunc               func(ctx context.Context, options *resend.ListContactImportsOptions) iter.Seq2[resend.ContactImport, error]
+}
+
+var _ resend.ContactImportsSvc = (*ContactImportsMock)(nil)
+
+// Create records the call and invokes CreateFunc.
+func (m *ContactImportsMock) Create(params *resend.CreateContactImportRequest) (resend.CreateContactImportResponse, error) {
+	m.record("Create", params)
+	if m.CreateFunc == nil {
+		var r0 resend.CreateContactImportResponse
+		return r0, notConfigured("ContactImports.Create")
+	}
+	return m.CreateFunc(params)
+}
+
+// CreateWithContext records the call and invokes CreateWithContextFunc.
+func (m *ContactImportsMock) CreateWithContext(ctx context.Context, params *resend.CreateContactImportRequest) (resend.CreateContactImportResponse, error) {
+	m.record("CreateWithContext", ctx, params)
+	if m.CreateWithContextFunc == nil {
+		var r0 resend.CreateContactImportResponse
+		return r0, notConfigured("ContactImports.CreateWithContext")
+	}
+	return m.CreateWithContextFunc(ctx, params)
+}
+
+// Get records the call and invokes GetFunc.
+func (m *ContactImportsMock) Get(id string) (resend.ContactImport, error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		var r0 resend.ContactImport
+		return r0, notConfigured("ContactImports.Get")
+	}
+	return m.GetFunc(id)
+}
+
+// GetWithContext records the call and invokes GetWithContextFunc.
+func (m *ContactImportsMock) GetWithContext(ctx context.Context, id string) (resend.ContactImport, error) {
+	m.record("GetWithContext", ctx, id)
+	if m.GetWithContextFunc == nil {
+		var r0 resend.ContactImport
+		return r0, notConfigured("ContactImports.GetWithContext")
+	}
+	return m.GetWithContextFunc(ctx, id)
+}
+
+// List records the call and invokes ListFunc.
+func (m *ContactImportsMock) List(options *resend.ListContactImportsOptions) (resend.ListContactImportsResponse, error) {
+	m.record("List", options)
+	if m.ListFunc == nil {
+		var r0 resend.ListContactImportsResponse
+		return r0, notConfigured("ContactImports.List")
+	}
+	return m.ListFunc(options)
+}
+
+// ListWithContext records the call and invokes ListWithContextFunc.
+func (m *ContactImportsMock) ListWithContext(ctx context.Context, options *resend.ListContactImportsOptions) (resend.ListContactImportsResponse, error) {
+	m.record("ListWithContext", ctx, options)
+	if m.ListWithContextFunc == nil {
+		var r0 resend.ListContactImportsResponse
+		return r0, notConfigured("ContactImports.ListWithContext")
+	}
+	return m.ListWithContextFunc(ctx, options)
+}
+
+// All records the call and invokes AllFunc.
+func (m *ContactImportsMock) All(ctx context.Context, options *resend.ListContactImportsOptions) iter.Seq2[resend.ContactImport, error] {
+	m.record("All", ctx, options)
+	if m.AllFunc == nil {
+		return notConfiguredSeq[resend.ContactImport](notConfigured("ContactImports.All"))
+	}
+	return m.AllFunc(ctx, options)
+}
+
+// BroadcastsMock is a programmable fake of resend.BroadcastsSvc.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type BroadcastsMock struct {
+	Recorder
+
+	CreateWithContextFunc func(ctx context.Context, params *resend.CreateBroadcastRequest) (resend.CreateBroadcastResponse, error)
+	CreateFunc            func(params *resend.CreateBroadcastRequest) (resend.CreateBroadcastResponse, error)
+	UpdateWithContextFunc func(ctx context.Context, params *resend.UpdateBroadcastRequest) (resend.UpdateBroadcastResponse, error)
+	UpdateFunc            func(params *resend.UpdateBroadcastRequest) (resend.UpdateBroadcastResponse, error)
+	ListWithOptionsFunc   func(ctx context.Context, options *resend.ListOptions) (resend.ListBroadcastsResponse, error)
+	ListWithContextFunc   func(ctx context.Context) (resend.ListBroadcastsResponse, error)
+	ListFunc              func() (resend.ListBroadcastsResponse, error)
+	AllFunc               func(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.Broadcast, error]
+	GetWithContextFunc    func(ctx context.Context, broadcastId string) (resend.Broadcast, error)
+	GetFunc               func(broadcastId string) (resend.Broadcast, error)
+	SendWithContextFunc   func(ctx context.Context, params *resend.SendBroadcastRequest) (resend.SendBroadcastResponse, error)
+	SendFunc              func(params *resend.SendBroadcastRequest) (resend.SendBroadcastResponse, error)
+	CancelWithContextFunc func(ctx context.Context, broadcastId string) (resend.CancelBroadcastResponse, error)
+	CancelFunc            func(broadcastId string) (resend.CancelBroadcastResponse, error)
+	RemoveWithContextFunc func(ctx context.Context, broadcastId string) (resend.RemoveBroadcastResponse, error)
+	RemoveFunc            func(broadcastId string) (resend.RemoveBroadcastResponse, error)
+}
+
+var _ resend.BroadcastsSvc = (*BroadcastsMock)(nil)
+
+// CreateWithContext records the call and invokes CreateWithContextFunc.
+func (m *BroadcastsMock) CreateWithContext(ctx context.Context, params *resend.CreateBroadcastRequest) (resend.CreateBroadcastResponse, error) {
+	m.record("CreateWithContext", ctx, params)
+	if m.CreateWithContextFunc == nil {
+		var r0 resend.CreateBroadcastResponse
+		return r0, notConfigured("Broadcasts.CreateWithContext")
+	}
+	return m.CreateWithContextFunc(ctx, params)
+}
+
+// Create records the call and invokes CreateFunc.
+func (m *BroadcastsMock) Create(params *resend.CreateBroadcastRequest) (resend.CreateBroadcastResponse, error) {
+	m.record("Create", params)
+	if m.CreateFunc == nil {
+		var r0 resend.CreateBroadcastResponse
+		return r0, notConfigured("Broadcasts.Create")
+	}
+	return m.CreateFunc(params)
+}
+
+// UpdateWithContext records the call and invokes UpdateWithContextFunc.
+func (m *BroadcastsMock) UpdateWithContext(ctx context.Context, params *resend.UpdateBroadcastRequest) (resend.UpdateBroadcastResponse, error) {
+	m.record("UpdateWithContext", ctx, params)
+	if m.UpdateWithContextFunc == nil {
+		var r0 resend.UpdateBroadcastResponse
+		return r0, notConfigured("Broadcasts.UpdateWithContext")
+	}
+	return m.UpdateWithContextFunc(ctx, params)
+}
+
+// Update records the call and invokes UpdateFunc.
+func (m *BroadcastsMock) Update(params *resend.UpdateBroadcastRequest) (resend.UpdateBroadcastResponse, error) {
+	m.record("Update", params)
+	if m.UpdateFunc == nil {
+		var r0 resend.UpdateBroadcastResponse
+		return r0, notConfigured("Broadcasts.Update")
+	}
+	return m.UpdateFunc(params)
+}
+
+// ListWithOptions records the call and invokes ListWithOptionsFunc.
+func (m *BroadcastsMock) ListWithOptions(ctx context.Context, options *resend.ListOptions) (resend.ListBroadcastsResponse, error) {
+	m.record("ListWithOptions", ctx, options)
+	if m.ListWithOptionsFunc == nil {
+		var r0 resend.ListBroadcastsResponse
+		return r0, notConfigured("Broadcasts.ListWithOptions")
+	}
+	return m.ListWithOptionsFunc(ctx, options)
+}
+
+// ListWithContext records the call and invokes ListWithContextFunc.
+func (m *BroadcastsMock) ListWithContext(ctx context.Context) (resend.ListBroadcastsResponse, error) {
+	m.record("ListWithContext", ctx)
+	if m.ListWithContextFunc == nil {
+		var r0 resend.ListBroadcastsResponse
+		return r0, notConfigured("Broadcasts.ListWithContext")
+	}
+	return m.ListWithContextFunc(ctx)
+}
+
+// List records the call and invokes ListFunc.
+func (m *BroadcastsMock) List() (resend.ListBroadcastsResponse, error) {
+	m.record("List")
+	if m.ListFunc == nil {
+		var r0 resend.ListBroadcastsResponse
+		return r0, notConfigured("Broadcasts.List")
+	}
+	return m.ListFunc()
+}
+
+// All records the call and invokes AllFunc.
+func (m *BroadcastsMock) All(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.Broadcast, error] {
+	m.record("All", ctx, options)
+	if m.AllFunc == nil {
+		return notConfiguredSeq[resend.Broadcast](notConfigured("Broadcasts.All"))
+	}
+	return m.AllFunc(ctx, options)
+}
+
+// GetWithContext records the call and invokes GetWithContextFunc.
+func (m *BroadcastsMock) GetWithContext(ctx context.Context, broadcastId string) (resend.Broadcast, error) {
+	m.record("GetWithContext", ctx, broadcastId)
+	if m.GetWithContextFunc == nil {
+		var r0 resend.Broadcast
+		return r0, notConfigured("Broadcasts.GetWithContext")
+	}
+	return m.GetWithContextFunc(ctx, broadcastId)
+}
+
+// Get records the call and invokes GetFunc.
+func (m *BroadcastsMock) Get(broadcastId string) (resend.Broadcast, error) {
+	m.record("Get", broadcastId)
+	if m.GetFunc == nil {
+		var r0 resend.Broadcast
+		return r0, notConfigured("Broadcasts.Get")
+	}
+	return m.GetFunc(broadcastId)
+}
+
+// SendWithContext records the call and invokes SendWithContextFunc.
+func (m *BroadcastsMock) SendWithContext(ctx context.Context, params *resend.SendBroadcastRequest) (resend.SendBroadcastResponse, error) {
+	m.record("SendWithContext", ctx, params)
+	if m.SendWithContextFunc == nil {
+		var r0 resend.SendBroadcastResponse
+		return r0, notConfigured("Broadcasts.SendWithContext")
+	}
+	return m.SendWithContextFunc(ctx, params)
+}
+
+// Send records the call and invokes SendFunc.
+func (m *BroadcastsMock) Send(params *resend.SendBroadcastRequest) (resend.SendBroadcastResponse, error) {
+	m.record("Send", params)
+	if m.SendFunc == nil {
+		var r0 resend.SendBroadcastResponse
+		return r0, notConfigured("Broadcasts.Send")
+	}
+	return m.SendFunc(params)
+}
+
+// CancelWithContext records the call and invokes CancelWithContextFunc.
+func (m *BroadcastsMock) CancelWithContext(ctx context.Context, broadcastId string) (resend.CancelBroadcastResponse, error) {
+	m.record("CancelWithContext", ctx, broadcastId)
+	if m.CancelWithContextFunc == nil {
+		var r0 resend.CancelBroadcastResponse
+		return r0, notConfigured("Broadcasts.CancelWithContext")
+	}
+	return m.CancelWithContextFunc(ctx, broadcastId)
+}
+
+// Cancel records the call and invokes CancelFunc.
+func (m *BroadcastsMock) Cancel(broadcastId string) (resend.CancelBroadcastResponse, error) {
+	m.record("Cancel", broadcastId)
+	if m.CancelFunc == nil {
+		var r0 resend.CancelBroadcastResponse
+		return r0, notConfigured("Broadcasts.Cancel")
+	}
+	return m.CancelFunc(broadcastId)
+}
+
+// RemoveWithContext records the call and invokes RemoveWithContextFunc.
+func (m *BroadcastsMock) RemoveWithContext(ctx context.Context, broadcastId string) (resend.RemoveBroadcastResponse, error) {
+	m.record("RemoveWithContext", ctx, broadcastId)
+	if m.RemoveWithContextFunc == nil {
+		var r0 resend.RemoveBroadcastResponse
+		return r0, notConfigured("Broadcasts.RemoveWithContext")
+	}
+	return m.RemoveWithContextFunc(ctx, broadcastId)
+}
+
+// Remove records the call and invokes RemoveFunc.
+func (m *BroadcastsMock) Remove(broadcastId string) (resend.RemoveBroadcastResponse, error) {
+	m.record("Remove", broadcastId)
+	if m.RemoveFunc == nil {
+		var r0 resend.RemoveBroadcastResponse
+		return r0, notConfigured("Broadcasts.Remove")
+	}
+	return m.RemoveFunc(broadcastId)
+}
+
+// TemplatesMock is a programmable fake of resend.TemplatesSvc.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type TemplatesMock struct {
+	Recorder
+
+	CreateWithContextFunc    func(ctx context.Context, params *resend.CreateTemplateRequest) (*resend.CreateTemplateResponse, error)
+	CreateFunc               func(params *resend.CreateTemplateRequest) (*resend.CreateTemplateResponse, error)
+	GetWithContextFunc       func(ctx context.Context, identifier string) (*resend.Template, error)
+	GetFunc                  func(identifier string) (*resend.Template, error)
+	ListWithContextFunc      func(ctx context.Context, options *resend.ListOptions) (*resend.ListTemplatesResponse, error)
+	ListFunc                 func(options *resend.ListOptions) (*resend.ListTemplatesResponse, error)
+	AllFunc                  func(ctx context.Context, options *resend.ListOptions) iter.Seq2[*resend.TemplateListItem, error]
+	UpdateWithContextFunc    func(ctx context.Context, identifier string, params *resend.UpdateTemplateRequest) (*resend.UpdateTemplateResponse, error)
+	UpdateFunc               func(identifier string, params *resend.UpdateTemplateRequest) (*resend.UpdateTemplateResponse, error)
+	PublishWithContextFunc   func(ctx context.Context, identifier string) (*resend.PublishTemplateResponse, error)
+	PublishFunc              func(identifier string) (*resend.PublishTemplateResponse, error)
+	DuplicateWithContextFunc func(ctx context.Context, identifier string) (*resend.DuplicateTemplateResponse, error)
+	DuplicateFunc            func(identifier string) (*resend.DuplicateTemplateResponse, error)
+	RemoveWithContextFunc    func(ctx context.Context, identifier string) (*resend.RemoveTemplateResponse, error)
+	RemoveFunc               func(identifier string) (*resend.RemoveTemplateResponse, error)
+}
+
+var _ resend.TemplatesSvc = (*TemplatesMock)(nil)
+
+// CreateWithContext records the call and invokes CreateWithContextFunc.
+func (m *TemplatesMock) CreateWithContext(ctx context.Context, params *resend.CreateTemplateRequest) (*resend.CreateTemplateResponse, error) {
+	m.record("CreateWithContext", ctx, params)
+	if m.CreateWithContextFunc == nil {
+		var r0 *resend.CreateTemplateResponse
+		return r0, notConfigured("Templates.CreateWithContext")
+	}
+	return m.CreateWithContextFunc(ctx, params)
+}
+
+// Create records the call and invokes CreateFunc.
+func (m *TemplatesMock) Create(params *resend.CreateTemplateRequest) (*resend.CreateTemplateResponse, error) {
+	m.record("Create", params)
+	if m.CreateFunc == nil {
+		var r0 *resend.CreateTemplateResponse
+		return r0, notConfigured("Templates.Create")
+	}
+	return m.CreateFunc(params)
+}
+
+// GetWithContext records the call and invokes GetWithContextFunc.
+func (m *TemplatesMock) GetWithContext(ctx context.Context, identifier string) (*resend.Template, error) {
+	m.record("GetWithContext", ctx, identifier)
+	if m.GetWithContextFunc == nil {
+		var r0 *resend.Template
+		return r0, notConfigured("Templates.GetWithContext")
+	}
+	return m.GetWithContextFunc(ctx, identifier)
+}
+
+// Get records the call and invokes GetFunc.
+func (m *TemplatesMock) Get(identifier string) (*resend.Template, error) {
+	m.record("Get", identifier)
+	if m.GetFunc == nil {
+		var r0 *resend.Template
+		return r0, notConfigured("Templates.Get")
+	}
+	return m.GetFunc(identifier)
+}
+
+// ListWithContext records the call and invokes ListWithContextFunc.
+func (m *TemplatesMock) ListWithContext(ctx context.Context, options *resend.ListOptions) (*resend.ListTemplatesResponse, error) {
+	m.record("ListWithContext", ctx, options)
+	if m.ListWithContextFunc == nil {
+		var r0 *resend.ListTemplatesResponse
+		return r0, notConfigured("Templates.ListWithContext")
+	}
+	return m.ListWithContextFunc(ctx, options)
+}
+
+// List records the call and invokes ListFunc.
+func (m *TemplatesMock) List(options *resend.ListOptions) (*resend.ListTemplatesResponse, error) {
+	m.record("List", options)
+	if m.ListFunc == nil {
+		var r0 *resend.ListTemplatesResponse
+		return r0, notConfigured("Templates.List")
+	}
+	return m.ListFunc(options)
+}
+
+// All records the call and invokes AllFunc.
+func (m *TemplatesMock) All(ctx context.Context, options *resend.ListOptions) iter.Seq2[*resend.TemplateListItem, error] {
+	m.record("All", ctx, options)
+	if m.AllFunc == nil {
+		return notConfiguredSeq[*resend.TemplateListItem](notConfigured("Templates.All"))
+	}
+	return m.AllFunc(ctx, options)
+}
+
+// UpdateWithContext records the call and invokes UpdateWithContextFunc.
+func (m *TemplatesMock) UpdateWithContext(ctx context.Context, identifier string, params *resend.UpdateTemplateRequest) (*resend.UpdateTemplateResponse, error) {
+	m.record("UpdateWithContext", ctx, identifier, params)
+	if m.UpdateWithContextFunc == nil {
+		var r0 *resend.UpdateTemplateResponse
+		return r0, notConfigured("Templates.UpdateWithContext")
+	}
+	return m.UpdateWithContextFunc(ctx, identifier, params)
+}
+
+// Update records the call and invokes UpdateFunc.
+func (m *TemplatesMock) Update(identifier string, params *resend.UpdateTemplateRequest) (*resend.UpdateTemplateResponse, error) {
+	m.record("Update", identifier, params)
+	if m.UpdateFunc == nil {
+		var r0 *resend.UpdateTemplateResponse
+		return r0, notConfigured("Templates.Update")
+	}
+	return m.UpdateFunc(identifier, params)
+}
+
+// PublishWithContext records the call and invokes PublishWithContextFunc.
+func (m *TemplatesMock) PublishWithContext(ctx context.Context, identifier string) (*resend.PublishTemplateResponse, error) {
+	m.record("PublishWithContext", ctx, identifier)
+	if m.PublishWithContextFunc == nil {
+		var r0 *resend.PublishTemplateResponse
+		return r0, notConfigured("Templates.PublishWithContext")
+	}
+	return m.PublishWithContextFunc(ctx, identifier)
+}
+
+// Publish records the call and invokes PublishFunc.
+func (m *TemplatesMock) Publish(identifier string) (*resend.PublishTemplateResponse, error) {
+	m.record("Publish", identifier)
+	if m.PublishFunc == nil {
+		var r0 *resend.PublishTemplateResponse
+		return r0, notConfigured("Templates.Publish")
+	}
+	return m.PublishFunc(identifier)
+}
+
+// DuplicateWithContext records the call and invokes DuplicateWithContextFunc.
+func (m *TemplatesMock) DuplicateWithContext(ctx context.Context, identifier string) (*resend.DuplicateTemplateResponse, error) {
+	m.record("DuplicateWithContext", ctx, identifier)
+	if m.DuplicateWithContextFunc == nil {
+		var r0 *resend.DuplicateTemplateResponse
+		return r0, notConfigured("Templates.DuplicateWithContext")
+	}
+	return m.DuplicateWithContextFunc(ctx, identifier)
+}
+
+// Duplicate records the call and invokes DuplicateFunc.
+func (m *TemplatesMock) Duplicate(identifier string) (*resend.DuplicateTemplateResponse, error) {
+	m.record("Duplicate", identifier)
+	if m.DuplicateFunc == nil {
+		var r0 *resend.DuplicateTemplateResponse
+		return r0, notConfigured("Templates.Duplicate")
+	}
+	return m.DuplicateFunc(identifier)
+}
+
+// RemoveWithContext records the call and invokes RemoveWithContextFunc.
+func (m *TemplatesMock) RemoveWithContext(ctx context.Context, identifier string) (*resend.RemoveTemplateResponse, error) {
+	m.record("RemoveWithContext", ctx, identifier)
+	if m.RemoveWithContextFunc == nil {
+		var r0 *resend.RemoveTemplateResponse
+		return r0, notConfigured("Templates.RemoveWithContext")
+	}
+	return m.RemoveWithContextFunc(ctx, identifier)
+}
+
+// Remove records the call and invokes RemoveFunc.
+func (m *TemplatesMock) Remove(identifier string) (*resend.RemoveTemplateResponse, error) {
+	m.record("Remove", identifier)
+	if m.RemoveFunc == nil {
+		var r0 *resend.RemoveTemplateResponse
+		return r0, notConfigured("Templates.Remove")
+	}
+	return m.RemoveFunc(identifier)
+}
+
+// TopicsMock is a programmable fake of resend.TopicsSvc.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type TopicsMock struct {
+	Recorder
+
+	CreateWithContextFunc func(ctx context.Context, params *resend.CreateTopicRequest) (*resend.CreateTopicResponse, error)
+	CreateFunc            func(params *resend.CreateTopicRequest) (*resend.CreateTopicResponse, error)
+	GetWithContextFunc    func(ctx context.Context, topicId string) (*resend.Topic, error)
+	GetFunc               func(topicId string) (*resend.Topic, error)
+	ListWithContextFunc   func(ctx context.Context, options *resend.ListOptions) (*resend.ListTopicsResponse, error)
+	ListFunc              func(options *resend.ListOptions) (*resend.ListTopicsResponse, error)
+	AllFunc               func(ctx context.Context, options *resend.ListOptions) iter.Seq2[*resend.Topic, error]
+	UpdateWithContextFunc func(ctx context.Context, topicId string, params *resend.UpdateTopicRequest) (*resend.UpdateTopicResponse, error)
+	UpdateFunc            func(topicId string, params *resend.UpdateTopicRequest) (*resend.UpdateTopicResponse, error)
+	RemoveWithContextFunc func(ctx context.Context, topicId string) (*resend.RemoveTopicResponse, error)
+	RemoveFunc            func(topicId string) (*resend.RemoveTopicResponse, error)
+}
+
+var _ resend.TopicsSvc = (*TopicsMock)(nil)
+
+// CreateWithContext records the call and invokes CreateWithContextFunc.
+func (m *TopicsMock) CreateWithContext(ctx context.Context, params *resend.CreateTopicRequest) (*resend.CreateTopicResponse, error) {
+	m.record("CreateWithContext", ctx, params)
+	if m.CreateWithContextFunc == nil {
+		var r0 *resend.CreateTopicResponse
+		return r0, notConfigured("Topics.CreateWithContext")
+	}
+	return m.CreateWithContextFunc(ctx, params)
+}
+
+// Create records the call and invokes CreateFunc.
+func (m *TopicsMock) Create(params *resend.CreateTopicRequest) (*resend.CreateTopicResponse, error) {
+	m.record("Create", params)
+	if m.CreateFunc == nil {
+		var r0 *resend.CreateTopicResponse
+		return r0, notConfigured("Topics.Create")
+	}
+	return m.CreateFunc(params)
+}
+
+// GetWithContext records the call and invokes GetWithContextFunc.
+func (m *TopicsMock) GetWithContext(ctx context.Context, topicId string) (*resend.Topic, error) {
+	m.record("GetWithContext", ctx, topicId)
+	if m.GetWithContextFunc == nil {
+		var r0 *resend.Topic
+		return r0, notConfigured("Topics.GetWithContext")
+	}
+	return m.GetWithContextFunc(ctx, topicId)
+}
+
+// Get records the call and invokes GetFunc.
+func (m *TopicsMock) Get(topicId string) (*resend.Topic, error) {
+	m.record("Get", topicId)
+	if m.GetFunc == nil {
+		var r0 *resend.Topic
+		return r0, notConfigured("Topics.Get")
+	}
+	return m.GetFunc(topicId)
+}
+
+// ListWithContext records the call and invokes ListWithContextFunc.
+func (m *TopicsMock) ListWithContext(ctx context.Context, options *resend.ListOptions) (*resend.ListTopicsResponse, error) {
+	m.record("ListWithContext", ctx, options)
+	if m.ListWithContextFunc == nil {
+		var r0 *resend.ListTopicsResponse
+		return r0, notConfigured("Topics.ListWithContext")
+	}
+	return m.ListWithContextFunc(ctx, options)
+}
+
+// List records the call and invokes ListFunc.
+func (m *TopicsMock) List(options *resend.ListOptions) (*resend.ListTopicsResponse, error) {
+	m.record("List", options)
+	if m.ListFunc == nil {
+		var r0 *resend.ListTopicsResponse
+		return r0, notConfigured("Topics.List")
+	}
+	return m.ListFunc(options)
+}
+
+// All records the call and invokes AllFunc.
+func (m *TopicsMock) All(ctx context.Context, options *resend.ListOptions) iter.Seq2[*resend.Topic, error] {
+	m.record("All", ctx, options)
+	if m.AllFunc == nil {
+		return notConfiguredSeq[*resend.Topic](notConfigured("Topics.All"))
+	}
+	return m.AllFunc(ctx, options)
+}
+
+// UpdateWithContext records the call and invokes UpdateWithContextFunc.
+func (m *TopicsMock) UpdateWithContext(ctx context.Context, topicId string, params *resend.UpdateTopicRequest) (*resend.UpdateTopicResponse, error) {
+	m.record("UpdateWithContext", ctx, topicId, params)
+	if m.UpdateWithContextFunc == nil {
+		var r0 *resend.UpdateTopicResponse
+		return r0, notConfigured("Topics.UpdateWithContext")
+	}
+	return m.UpdateWithContextFunc(ctx, topicId, params)
+}
+
+// Update records the call and invokes UpdateFunc.
+func (m *TopicsMock) Update(topicId string, params *resend.UpdateTopicRequest) (*resend.UpdateTopicResponse, error) {
+	m.record("Update", topicId, params)
+	if m.UpdateFunc == nil {
+		var r0 *resend.UpdateTopicResponse
+		return r0, notConfigured("Topics.Update")
+	}
+	return m.UpdateFunc(topicId, params)
+}
+
+// RemoveWithContext records the call and invokes RemoveWithContextFunc.
+func (m *TopicsMock) RemoveWithContext(ctx context.Context, topicId string) (*resend.RemoveTopicResponse, error) {
+	m.record("RemoveWithContext", ctx, topicId)
+	if m.RemoveWithContextFunc == nil {
+		var r0 *resend.RemoveTopicResponse
+		return r0, notConfigured("Topics.RemoveWithContext")
+	}
+	return m.RemoveWithContextFunc(ctx, topicId)
+}
+
+// Remove records the call and invokes RemoveFunc.
+func (m *TopicsMock) Remove(topicId string) (*resend.RemoveTopicResponse, error) {
+	m.record("Remove", topicId)
+	if m.RemoveFunc == nil {
+		var r0 *resend.RemoveTopicResponse
+		return r0, notConfigured("Topics.Remove")
+	}
+	return m.RemoveFunc(topicId)
+}
+
+// WebhooksMock is a programmable fake of resend.WebhooksSvc.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type WebhooksMock struct {
+	Recorder
+
+	CreateWithContextFunc func(ctx context.Context, params *resend.CreateWebhookRequest) (*resend.CreateWebhookResponse, error)
+	CreateFunc            func(params *resend.CreateWebhookRequest) (*resend.CreateWebhookResponse, error)
+	GetWithContextFunc    func(ctx context.Context, webhookId string) (*resend.Webhook, error)
+	GetFunc               func(webhookId string) (*resend.Webhook, error)
+	UpdateWithContextFunc func(ctx context.Context, webhookId string, params *resend.UpdateWebhookRequest) (*resend.UpdateWebhookResponse, error)
+	UpdateFunc            func(webhookId string, params *resend.UpdateWebhookRequest) (*resend.UpdateWebhookResponse, error)
+	ListWithOptionsFunc   func(ctx context.Context, options *resend.ListOptions) (*resend.ListWebhooksResponse, error)
+	ListWithContextFunc   func(ctx context.Context) (*resend.ListWebhooksResponse, error)
+	ListFunc              func() (*resend.ListWebhooksResponse, error)
+	AllFunc               func(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.WebhookInList, error]
+	RemoveWithContextFunc func(ctx context.Context, webhookId string) (*resend.DeleteWebhookResponse, error)
+	RemoveFunc            func(webhookId string) (*resend.DeleteWebhookResponse, error)
+	VerifyFunc            func(options *resend.VerifyWebhookOptions) error
+}
+
+var _ resend.WebhooksSvc = (*WebhooksMock)(nil)
+
+// CreateWithContext records the call and invokes CreateWithContextFunc.
+func (m *WebhooksMock) CreateWithContext(ctx context.Context, params *resend.CreateWebhookRequest) (*resend.CreateWebhookResponse, error) {
+	m.record("CreateWithContext", ctx, params)
+	if m.CreateWithContextFunc == nil {
+		var r0 *resend.CreateWebhookResponse
+		return r0, notConfigured("Webhooks.CreateWithContext")
+	}
+	return m.CreateWithContextFunc(ctx, params)
+}
+
+// Create records the call and invokes CreateFunc.
+func (m *WebhooksMock) Create(params *resend.CreateWebhookRequest) (*resend.CreateWebhookResponse, error) {
+	m.record("Create", params)
+	if m.CreateFunc == nil {
+		var r0 *resend.CreateWebhookResponse
+		return r0, notConfigured("Webhooks.Create")
+	}
+	return m.CreateFunc(params)
+}
+
+// GetWithContext records the call and invokes GetWithContextFunc.
+func (m *WebhooksMock) GetWithContext(ctx context.Context, webhookId string) (*resend.Webhook, error) {
+	m.record("GetWithContext", ctx, webhookId)
+	if m.GetWithContextFunc == nil {
+		var r0 *resend.Webhook
+		return r0, notConfigured("Webhooks.GetWithContext")
+	}
+	return m.GetWithContextFunc(ctx, webhookId)
+}
+
+// Get records the call and invokes GetFunc.
+func (m *WebhooksMock) Get(webhookId string) (*resend.Webhook, error) {
+	m.record("Get", webhookId)
+	if m.GetFunc == nil {
+		var r0 *resend.Webhook
+		return r0, notConfigured("Webhooks.Get")
+	}
+	return m.GetFunc(webhookId)
+}
+
+// UpdateWithContext records the call and invokes UpdateWithContextFunc.
+func (m *WebhooksMock) UpdateWithContext(ctx context.Context, webhookId string, params *resend.UpdateWebhookRequest) (*resend.UpdateWebhookResponse, error) {
+	m.record("UpdateWithContext", ctx, webhookId, params)
+	if m.UpdateWithContextFunc == nil {
+		var r0 *resend.UpdateWebhookResponse
+		return r0, notConfigured("Webhooks.UpdateWithContext")
+	}
+	return m.UpdateWithContextFunc(ctx, webhookId, params)
+}
+
+// Update records the call and invokes UpdateFunc.
+func (m *WebhooksMock) Update(webhookId string, params *resend.UpdateWebhookRequest) (*resend.UpdateWebhookResponse, error) {
+	m.record("Update", webhookId, params)
+	if m.UpdateFunc == nil {
+		var r0 *resend.UpdateWebhookResponse
+		return r0, notConfigured("Webhooks.Update")
+	}
+	return m.UpdateFunc(webhookId, params)
+}
+
+// ListWithOptions records the call and invokes ListWithOptionsFunc.
+func (m *WebhooksMock) ListWithOptions(ctx context.Context, options *resend.ListOptions) (*resend.ListWebhooksResponse, error) {
+	m.record("ListWithOptions", ctx, options)
+	if m.ListWithOptionsFunc == nil {
+		var r0 *resend.ListWebhooksResponse
+		return r0, notConfigured("Webhooks.ListWithOptions")
+	}
+	return m.ListWithOptionsFunc(ctx, options)
+}
+
+// ListWithContext records the call and invokes ListWithContextFunc.
+func (m *WebhooksMock) ListWithContext(ctx context.Context) (*resend.ListWebhooksResponse, error) {
+	m.record("ListWithContext", ctx)
+	if m.ListWithContextFunc == nil {
+		var r0 *resend.ListWebhooksResponse
+		return r0, notConfigured("Webhooks.ListWithContext")
+	}
+	return m.ListWithContextFunc(ctx)
+}
+
+// List records the call and invokes ListFunc.
+func (m *WebhooksMock) List() (*resend.ListWebhooksResponse, error) {
+	m.record("List")
+	if m.ListFunc == nil {
+		var r0 *resend.ListWebhooksResponse
+		return r0, notConfigured("Webhooks.List")
+	}
+	return m.ListFunc()
+}
+
+// All records the call and invokes AllFunc.
+func (m *WebhooksMock) All(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.WebhookInList, error] {
+	m.record("All", ctx, options)
+	if m.AllFunc == nil {
+		return notConfiguredSeq[resend.WebhookInList](notConfigured("Webhooks.All"))
+	}
+	return m.AllFunc(ctx, options)
+}
+
+// RemoveWithContext records the call and invokes RemoveWithContextFunc.
+func (m *WebhooksMock) RemoveWithContext(ctx context.Context, webhookId string) (*resend.DeleteWebhookResponse, error) {
+	m.record("RemoveWithContext", ctx, webhookId)
+	if m.RemoveWithContextFunc == nil {
+		var r0 *resend.DeleteWebhookResponse
+		return r0, notConfigured("Webhooks.RemoveWithContext")
+	}
+	return m.RemoveWithContextFunc(ctx, webhookId)
+}
+
+// Remove records the call and invokes RemoveFunc.
+func (m *WebhooksMock) Remove(webhookId string) (*resend.DeleteWebhookResponse, error) {
+	m.record("Remove", webhookId)
+	if m.RemoveFunc == nil {
+		var r0 *resend.DeleteWebhookResponse
+		return r0, notConfigured("Webhooks.Remove")
+	}
+	return m.RemoveFunc(webhookId)
+}
+
+// Verify records the call and invokes VerifyFunc.
+func (m *WebhooksMock) Verify(options *resend.VerifyWebhookOptions) error {
+	m.record("Verify", options)
+	if m.VerifyFunc == nil {
+		return notConfigured("Webhooks.Verify")
+	}
+	return m.VerifyFunc(options)
+}
+
+// LogsMock is a programmable fake of resend.LogsSvc.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type LogsMock struct {
+	Recorder
+
+	GetWithContextFunc  func(ctx context.Context, logId string) (resend.Log, error)
+	GetFunc             func(logId string) (resend.Log, error)
+	ListWithOptionsFunc func(ctx context.Context, options *resend.ListOptions) (resend.ListLogsResponse, error)
+	ListWithContextFunc func(ctx context.Context) (resend.ListLogsResponse, error)
+	ListFunc            func() (resend.ListLogsResponse, error)
+	AllFunc             func(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.Log, error]
+}
+
+var _ resend.LogsSvc = (*LogsMock)(nil)
+
+// GetWithContext records the call and invokes GetWithContextFunc.
+func (m *LogsMock) GetWithContext(ctx context.Context, logId string) (resend.Log, error) {
+	m.record("GetWithContext", ctx, logId)
+	if m.GetWithContextFunc == nil {
+		var r0 resend.Log
+		return r0, notConfigured("Logs.GetWithContext")
+	}
+	return m.GetWithContextFunc(ctx, logId)
+}
+
+// Get records the call and invokes GetFunc.
+func (m *LogsMock) Get(logId string) (resend.Log, error) {
+	m.record("Get", logId)
+	if m.GetFunc == nil {
+		var r0 resend.Log
+		return r0, notConfigured("Logs.Get")
+	}
+	return m.GetFunc(logId)
+}
+
+// ListWithOptions records the call and invokes ListWithOptionsFunc.
+func (m *LogsMock) ListWithOptions(ctx context.Context, options *resend.ListOptions) (resend.ListLogsResponse, error) {
+	m.record("ListWithOptions", ctx, options)
+	if m.ListWithOptionsFunc == nil {
+		var r0 resend.ListLogsResponse
+		return r0, notConfigured("Logs.ListWithOptions")
+	}
+	return m.ListWithOptionsFunc(ctx, options)
+}
+
+// ListWithContext records the call and invokes ListWithContextFunc.
+func (m *LogsMock) ListWithContext(ctx context.Context) (resend.ListLogsResponse, error) {
+	m.record("ListWithContext", ctx)
+	if m.ListWithContextFunc == nil {
+		var r0 resend.ListLogsResponse
+		return r0, notConfigured("Logs.ListWithContext")
+	}
+	return m.ListWithContextFunc(ctx)
+}
+
+// List records the call and invokes ListFunc.
+func (m *LogsMock) List() (resend.ListLogsResponse, error) {
+	m.record("List")
+	if m.ListFunc == nil {
+		var r0 resend.ListLogsResponse
+		return r0, notConfigured("Logs.List")
+	}
+	return m.ListFunc()
+}
+
+// All records the call and invokes AllFunc.
+func (m *LogsMock) All(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.Log, error] {
+	m.record("All", ctx, options)
+	if m.AllFunc == nil {
+		return notConfiguredSeq[resend.Log](notConfigured("Logs.All"))
+	}
+	return m.AllFunc(ctx, options)
+}
+
+// AutomationsMock is a programmable fake of resend.AutomationsSvc.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type AutomationsMock struct {
+	Recorder
+
+	CreateWithContextFunc    func(ctx context.Context, params *resend.CreateAutomationRequest) (resend.CreateAutomationResponse, error)
+	CreateFunc               func(params *resend.CreateAutomationRequest) (resend.CreateAutomationResponse, error)
+	GetWithContextFunc       func(ctx context.Context, automationId string) (resend.Automation, error)
+	GetFunc                  func(automationId string) (resend.Automation, error)
+	ListWithContextFunc      func(ctx context.Context) (resend.ListAutomationsResponse, error)
+	ListFunc                 func() (resend.ListAutomationsResponse, error)
+	ListWithOptionsFunc      func(ctx context.Context, options *resend.ListAutomationsOptions) (resend.ListAutomationsResponse, error)
+	AllFunc                  func(ctx context.Context, options *resend.ListAutomationsOptions) iter.Seq2[resend.AutomationListItem, error]
+	UpdateWithContextFunc    func(ctx context.Context, automationId string, params *resend.UpdateAutomationRequest) (resend.UpdateAutomationResponse, error)
+	UpdateFunc               func(automationId string, params *resend.UpdateAutomationRequest) (resend.UpdateAutomationResponse, error)
+	RemoveWithContextFunc    func(ctx context.Context, automationId string) (resend.DeleteAutomationResponse, error)
+	RemoveFunc               func(automationId string) (resend.DeleteAutomationResponse, error)
+	DuplicateWithContextFunc func(ctx context.Context, automationId string) (resend.DuplicateAutomationResponse, error)
+	DuplicateFunc            func(automationId string) (resend.DuplicateAutomationResponse, error)
+	StopWithContextFunc      func(ctx context.Context, automationId string) (resend.StopAutomationResponse, error)
+	StopFunc                 func(automationId string) (resend.StopAutomationResponse, error)
+	ListRunsWithContextFunc  func(ctx context.Context, automationId string, options *resend.ListAutomationRunsOptions) (resend.ListAutomationRunsResponse, error)
+	ListRunsFunc             func(automationId string) (resend.ListAutomationRunsResponse, error)
+	AllRunsFunc              func(ctx context.Context, automationId string, options *resend.ListAutomationRunsOptions) iter.Seq2[resend.AutomationRunListItem, error]
+	GetRunWithContextFunc    func(ctx context.Context, automationId string, runId string) (resend.AutomationRun, error)
+	GetRunFunc               func(automationId string, runId string) (resend.AutomationRun, error)
+}
+
+var _ resend.AutomationsSvc = (*AutomationsMock)(nil)
+
+// CreateWithContext records the call and invokes CreateWithContextFunc.
+func (m *AutomationsMock) CreateWithContext(ctx context.Context, params *resend.CreateAutomationRequest) (resend.CreateAutomationResponse, error) {
+	m.record("CreateWithContext", ctx, params)
+	if m.CreateWithContextFunc == nil {
+		var r0 resend.CreateAutomationResponse
+		return r0, notConfigured("Automations.CreateWithContext")
+	}
+	return m.CreateWithContextFunc(ctx, params)
+}
+
+// Create records the call and invokes CreateFunc.
+func (m *AutomationsMock) Create(params *resend.CreateAutomationRequest) (resend.CreateAutomationResponse, error) {
+	m.record("Create", params)
+	if m.CreateFunc == nil {
+		var r0 resend.CreateAutomationResponse
+		return r0, notConfigured("Automations.Create")
+	}
+	return m.CreateFunc(params)
+}
+
+// GetWithContext records the call and invokes GetWithContextFunc.
+func (m *AutomationsMock) GetWithContext(ctx context.Context, automationId string) (resend.Automation, error) {
+	m.record("GetWithContext", ctx, automationId)
+	if m.GetWithContextFunc == nil {
+		var r0 resend.Automation
+		return r0, notConfigured("Automations.GetWithContext")
+	}
+	return m.GetWithContextFunc(ctx, automationId)
+}
+
+// Get records the call and invokes GetFunc.
+func (m *AutomationsMock) Get(automationId string) (resend.Automation, error) {
+	m.record("Get", automationId)
+	if m.GetFunc == nil {
+		var r0 resend.Automation
+		return r0, notConfigured("Automations.Get")
+	}
+	return m.GetFunc(automationId)
+}
+
+// ListWithContext records the call and invokes ListWithContextFunc.
+func (m *AutomationsMock) ListWithContext(ctx context.Context) (resend.ListAutomationsResponse, error) {
+	m.record("ListWithContext", ctx)
+	if m.ListWithContextFunc == nil {
+		var r0 resend.ListAutomationsResponse
+		return r0, notConfigured("Automations.ListWithContext")
+	}
+	return m.ListWithContextFunc(ctx)
+}
+
+// List records the call and invokes ListFunc.
+func (m *AutomationsMock) List() (resend.ListAutomationsResponse, error) {
+	m.record("List")
+	if m.ListFunc == nil {
+		var r0 resend.ListAutomationsResponse
+		return r0, notConfigured("Automations.List")
+	}
+	return m.ListFunc()
+}
+
+// ListWithOptions records the call and invokes ListWithOptionsFunc.
+func (m *AutomationsMock) ListWithOptions(ctx context.Context, options *resend.ListAutomationsOptions) (resend.ListAutomationsResponse, error) {
+	m.record("ListWithOptions", ctx, options)
+	if m.ListWithOptionsFunc == nil {
+		var r0 resend.ListAutomationsResponse
+		return r0, notConfigured("Automations.ListWithOptions")
+	}
+	return m.ListWithOptionsFunc(ctx, options)
+}
+
+// All records the call and invokes AllFunc.
+func (m *AutomationsMock) All(ctx context.Context, options *resend.ListAutomationsOptions) iter.Seq2[resend.AutomationListItem, error] {
+	m.record("All", ctx, options)
+	if m.AllFunc == nil {
+		return notConfiguredSeq[resend.AutomationListItem](notConfigured("Automations.All"))
+	}
+	return m.AllFunc(ctx, options)
+}
+
+// UpdateWithContext records the call and invokes UpdateWithContextFunc.
+func (m *AutomationsMock) UpdateWithContext(ctx context.Context, automationId string, params *resend.UpdateAutomationRequest) (resend.UpdateAutomationResponse, error) {
+	m.record("UpdateWithContext", ctx, automationId, params)
+	if m.UpdateWithContextFunc == nil {
+		var r0 resend.UpdateAutomationResponse
+		return r0, notConfigured("Automations.UpdateWithContext")
+	}
+	return m.UpdateWithContextFunc(ctx, automationId, params)
+}
+
+// Update records the call and invokes UpdateFunc.
+func (m *AutomationsMock) Update(automationId string, params *resend.UpdateAutomationRequest) (resend.UpdateAutomationResponse, error) {
+	m.record("Update", automationId, params)
+	if m.UpdateFunc == nil {
+		var r0 resend.UpdateAutomationResponse
+		return r0, notConfigured("Automations.Update")
+	}
+	return m.UpdateFunc(automationId, params)
+}
+
+// RemoveWithContext records the call and invokes RemoveWithContextFunc.
+func (m *AutomationsMock) RemoveWithContext(ctx context.Context, automationId string) (resend.DeleteAutomationResponse, error) {
+	m.record("RemoveWithContext", ctx, automationId)
+	if m.RemoveWithContextFunc == nil {
+		var r0 resend.DeleteAutomationResponse
+		return r0, notConfigured("Automations.RemoveWithContext")
+	}
+	return m.RemoveWithContextFunc(ctx, automationId)
+}
+
+// Remove records the call and invokes RemoveFunc.
+func (m *AutomationsMock) Remove(automationId string) (resend.DeleteAutomationResponse, error) {
+	m.record("Remove", automationId)
+	if m.RemoveFunc == nil {
+		var r0 resend.DeleteAutomationResponse
+		return r0, notConfigured("Automations.Remove")
+	}
+	return m.RemoveFunc(automationId)
+}
+
+// DuplicateWithContext records the call and invokes DuplicateWithContextFunc.
+func (m *AutomationsMock) DuplicateWithContext(ctx context.Context, automationId string) (resend.DuplicateAutomationResponse, error) {
+	m.record("DuplicateWithContext", ctx, automationId)
+	if m.DuplicateWithContextFunc == nil {
+		var r0 resend.DuplicateAutomationResponse
+		return r0, notConfigured("Automations.DuplicateWithContext")
+	}
+	return m.DuplicateWithContextFunc(ctx, automationId)
+}
+
+// Duplicate records the call and invokes DuplicateFunc.
+func (m *AutomationsMock) Duplicate(automationId string) (resend.DuplicateAutomationResponse, error) {
+	m.record("Duplicate", automationId)
+	if m.DuplicateFunc == nil {
+		var r0 resend.DuplicateAutomationResponse
+		return r0, notConfigured("Automations.Duplicate")
+	}
+	return m.DuplicateFunc(automationId)
+}
+
+// StopWithContext records the call and invokes StopWithContextFunc.
+func (m *AutomationsMock) StopWithContext(ctx context.Context, automationId string) (resend.StopAutomationResponse, error) {
+	m.record("StopWithContext", ctx, automationId)
+	if m.StopWithContextFunc == nil {
+		var r0 resend.StopAutomationResponse
+		return r0, notConfigured("Automations.StopWithContext")
+	}
+	return m.StopWithContextFunc(ctx, automationId)
+}
+
+// Stop records the call and invokes StopFunc.
+func (m *AutomationsMock) Stop(automationId string) (resend.StopAutomationResponse, error) {
+	m.record("Stop", automationId)
+	if m.StopFunc == nil {
+		var r0 resend.StopAutomationResponse
+		return r0, notConfigured("Automations.Stop")
+	}
+	return m.StopFunc(automationId)
+}
+
+// ListRunsWithContext records the call and invokes ListRunsWithContextFunc.
+func (m *AutomationsMock) ListRunsWithContext(ctx context.Context, automationId string, options *resend.ListAutomationRunsOptions) (resend.ListAutomationRunsResponse, error) {
+	m.record("ListRunsWithContext", ctx, automationId, options)
+	if m.ListRunsWithContextFunc == nil {
+		var r0 resend.ListAutomationRunsResponse
+		return r0, notConfigured("Automations.ListRunsWithContext")
+	}
+	return m.ListRunsWithContextFunc(ctx, automationId, options)
+}
+
+// ListRuns records the call and invokes ListRunsFunc.
+func (m *AutomationsMock) ListRuns(automationId string) (resend.ListAutomationRunsResponse, error) {
+	m.record("ListRuns", automationId)
+	if m.ListRunsFunc == nil {
+		var r0 resend.ListAutomationRunsResponse
+		return r0, notConfigured("Automations.ListRuns")
+	}
+	return m.ListRunsFunc(automationId)
+}
+
+// AllRuns records the call and invokes AllRunsFunc.
+func (m *AutomationsMock) AllRuns(ctx context.Context, automationId string, options *resend.ListAutomationRunsOptions) iter.Seq2[resend.AutomationRunListItem, error] {
+	m.record("AllRuns", ctx, automationId, options)
+	if m.AllRunsFunc == nil {
+		return notConfiguredSeq[resend.AutomationRunListItem](notConfigured("Automations.AllRuns"))
+	}
+	return m.AllRunsFunc(ctx, automationId, options)
+}
+
+// GetRunWithContext records the call and invokes GetRunWithContextFunc.
+func (m *AutomationsMock) GetRunWithContext(ctx context.Context, automationId string, runId string) (resend.AutomationRun, error) {
+	m.record("GetRunWithContext", ctx, automationId, runId)
+	if m.GetRunWithContextFunc == nil {
+		var r0 resend.AutomationRun
+		return r0, notConfigured("Automations.GetRunWithContext")
+	}
+	return m.GetRunWithContextFunc(ctx, automationId, runId)
+}
+
+// GetRun records the call and invokes GetRunFunc.
+func (m *AutomationsMock) GetRun(automationId string, runId string) (resend.AutomationRun, error) {
+	m.record("GetRun", automationId, runId)
+	if m.GetRunFunc == nil {
+		var r0 resend.AutomationRun
+		return r0, notConfigured("Automations.GetRun")
+	}
+	return m.GetRunFunc(automationId, runId)
+}
+
+// EventsMock is a programmable fake of resend.EventsSvc.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type EventsMock struct {
+	Recorder
+
+	CreateWithContextFunc func(ctx context.Context, params *resend.CreateEventRequest) (resend.CreateEventResponse, error)
+	CreateFunc            func(params *resend.CreateEventRequest) (resend.CreateEventResponse, error)
+	GetWithContextFunc    func(ctx context.Context, identifier string) (resend.Event, error)
+	GetFunc               func(identifier string) (resend.Event, error)
+	ListWithContextFunc   func(ctx context.Context) (resend.ListEventsResponse, error)
+	ListFunc              func() (resend.ListEventsResponse, error)
+	AllFunc               func(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.EventSummary, error]
+	ListWithOptionsFunc   func(ctx context.Context, options *resend.ListOptions) (resend.ListEventsResponse, error)
+	UpdateWithContextFunc func(ctx context.Context, identifier string, params *resend.UpdateEventRequest) (resend.UpdateEventResponse, error)
+	UpdateFunc            func(identifier string, params *resend.UpdateEventRequest) (resend.UpdateEventResponse, error)
+	RemoveWithContextFunc func(ctx context.Context, identifier string) (resend.DeleteEventResponse, error)
+	RemoveFunc            func(identifier string) (resend.DeleteEventResponse, error)
+	SendWithContextFunc   func(ctx context.Context, params *resend.SendEventRequest) (resend.SendEventResponse, error)
+	SendFunc              func(params *resend.SendEventRequest) (resend.SendEventResponse, error)
+}
+
+var _ resend.EventsSvc = (*EventsMock)(nil)
+
+// CreateWithContext records the call and invokes CreateWithContextFunc.
+func (m *EventsMock) CreateWithContext(ctx context.Context, params *resend.CreateEventRequest) (resend.CreateEventResponse, error) {
+	m.record("CreateWithContext", ctx, params)
+	if m.CreateWithContextFunc == nil {
+		var r0 resend.CreateEventResponse
+		return r0, notConfigured("Events.CreateWithContext")
+	}
+	return m.CreateWithContextFunc(ctx, params)
+}
+
+// Create records the call and invokes CreateFunc.
+func (m *EventsMock) Create(params *resend.CreateEventRequest) (resend.CreateEventResponse, error) {
+	m.record("Create", params)
+	if m.CreateFunc == nil {
+		var r0 resend.CreateEventResponse
+		return r0, notConfigured("Events.Create")
+	}
+	return m.CreateFunc(params)
+}
+
+// GetWithContext records the call and invokes GetWithContextFunc.
+func (m *EventsMock) GetWithContext(ctx context.Context, identifier string) (resend.Event, error) {
+	m.record("GetWithContext", ctx, identifier)
+	if m.GetWithContextFunc == nil {
+		var r0 resend.Event
+		return r0, notConfigured("Events.GetWithContext")
+	}
+	return m.GetWithContextFunc(ctx, identifier)
+}
+
+// Get records the call and invokes GetFunc.
+func (m *EventsMock) Get(identifier string) (resend.Event, error) {
+	m.record("Get", identifier)
+	if m.GetFunc == nil {
+		var r0 resend.Event
+		return r0, notConfigured("Events.Get")
+	}
+	return m.GetFunc(identifier)
+}
+
+// ListWithContext records the call and invokes ListWithContextFunc.
+func (m *EventsMock) ListWithContext(ctx context.Context) (resend.ListEventsResponse, error) {
+	m.record("ListWithContext", ctx)
+	if m.ListWithContextFunc == nil {
+		var r0 resend.ListEventsResponse
+		return r0, notConfigured("Events.ListWithContext")
+	}
+	return m.ListWithContextFunc(ctx)
+}
+
+// List records the call and invokes ListFunc.
+func (m *EventsMock) List() (resend.ListEventsResponse, error) {
+	m.record("List")
+	if m.ListFunc == nil {
+		var r0 resend.ListEventsResponse
+		return r0, notConfigured("Events.List")
+	}
+	return m.ListFunc()
+}
+
+// All records the call and invokes AllFunc.
+func (m *EventsMock) All(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.EventSummary, error] {
+	m.record("All", ctx, options)
+	if m.AllFunc == nil {
+		return notConfiguredSeq[resend.EventSummary](notConfigured("Events.All"))
+	}
+	return m.AllFunc(ctx, options)
+}
+
+// ListWithOptions records the call and invokes ListWithOptionsFunc.
+func (m *EventsMock) ListWithOptions(ctx context.Context, options *resend.ListOptions) (resend.ListEventsResponse, error) {
+	m.record("ListWithOptions", ctx, options)
+	if m.ListWithOptionsFunc == nil {
+		var r0 resend.ListEventsResponse
+		return r0, notConfigured("Events.ListWithOptions")
+	}
+	return m.ListWithOptionsFunc(ctx, options)
+}
+
+// UpdateWithContext records the call and invokes UpdateWithContextFunc.
+func (m *EventsMock) UpdateWithContext(ctx context.Context, identifier string, params *resend.UpdateEventRequest) (resend.UpdateEventResponse, error) {
+	m.record("UpdateWithContext", ctx, identifier, params)
+	if m.UpdateWithContextFunc == nil {
+		var r0 resend.UpdateEventResponse
+		return r0, notConfigured("Events.UpdateWithContext")
+	}
+	return m.UpdateWithContextFunc(ctx, identifier, params)
+}
+
+// Update records the call and invokes UpdateFunc.
+func (m *EventsMock) Update(identifier string, params *resend.UpdateEventRequest) (resend.UpdateEventResponse, error) {
+	m.record("Update", identifier, params)
+	if m.UpdateFunc == nil {
+		var r0 resend.UpdateEventResponse
+		return r0, notConfigured("Events.Update")
+	}
+	return m.UpdateFunc(identifier, params)
+}
+
+// RemoveWithContext records the call and invokes RemoveWithContextFunc.
+func (m *EventsMock) RemoveWithContext(ctx context.Context, identifier string) (resend.DeleteEventResponse, error) {
+	m.record("RemoveWithContext", ctx, identifier)
+	if m.RemoveWithContextFunc == nil {
+		var r0 resend.DeleteEventResponse
+		return r0, notConfigured("Events.RemoveWithContext")
+	}
+	return m.RemoveWithContextFunc(ctx, identifier)
+}
+
+// Remove records the call and invokes RemoveFunc.
+func (m *EventsMock) Remove(identifier string) (resend.DeleteEventResponse, error) {
+	m.record("Remove", identifier)
+	if m.RemoveFunc == nil {
+		var r0 resend.DeleteEventResponse
+		return r0, notConfigured("Events.Remove")
+	}
+	return m.RemoveFunc(identifier)
+}
+
+// SendWithContext records the call and invokes SendWithContextFunc.
+func (m *EventsMock) SendWithContext(ctx context.Context, params *resend.SendEventRequest) (resend.SendEventResponse, error) {
+	m.record("SendWithContext", ctx, params)
+	if m.SendWithContextFunc == nil {
+		var r0 resend.SendEventResponse
+		return r0, notConfigured("Events.SendWithContext")
+	}
+	return m.SendWithContextFunc(ctx, params)
+}
+
+// Send records the call and invokes SendFunc.
+func (m *EventsMock) Send(params *resend.SendEventRequest) (resend.SendEventResponse, error) {
+	m.record("Send", params)
+	if m.SendFunc == nil {
+		var r0 resend.SendEventResponse
+		return r0, notConfigured("Events.Send")
+	}
+	return m.SendFunc(params)
+}
+
+// OAuthGrantsMock is a programmable fake of resend.OAuthGrantsSvc.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type OAuthGrantsMock struct {
+	Recorder
+
+	ListWithOptionsFunc   func(ctx context.Context, options *resend.ListOptions) (resend.ListOAuthGrantsResponse, error)
+	ListWithContextFunc   func(ctx context.Context) (resend.ListOAuthGrantsResponse, error)
+	ListFunc              func() (resend.ListOAuthGrantsResponse, error)
+	AllFunc               func(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.OAuthGrant, error]
+	RevokeWithContextFunc func(ctx context.Context, oauthGrantId string) (resend.RevokeOAuthGrantResponse, error)
+	RevokeFunc            func(oauthGrantId string) (resend.RevokeOAuthGrantResponse, error)
+}
+
+var _ resend.OAuthGrantsSvc = (*OAuthGrantsMock)(nil)
+
+// ListWithOptions records the call and invokes ListWithOptionsFunc.
+func (m *OAuthGrantsMock) ListWithOptions(ctx context.Context, options *resend.ListOptions) (resend.ListOAuthGrantsResponse, error) {
+	m.record("ListWithOptions", ctx, options)
+	if m.ListWithOptionsFunc == nil {
+		var r0 resend.ListOAuthGrantsResponse
+		return r0, notConfigured("OAuthGrants.ListWithOptions")
+	}
+	return m.ListWithOptionsFunc(ctx, options)
+}
+
+// ListWithContext records the call and invokes ListWithContextFunc.
+func (m *OAuthGrantsMock) ListWithContext(ctx context.Context) (resend.ListOAuthGrantsResponse, error) {
+	m.record("ListWithContext", ctx)
+	if m.ListWithContextFunc == nil {
+		var r0 resend.ListOAuthGrantsResponse
+		return r0, notConfigured("OAuthGrants.ListWithContext")
+	}
+	return m.ListWithContextFunc(ctx)
+}
+
+// List records the call and invokes ListFunc.
+func (m *OAuthGrantsMock) List() (resend.ListOAuthGrantsResponse, error) {
+	m.record("List")
+	if m.ListFunc == nil {
+		var r0 resend.ListOAuthGrantsResponse
+		return r0, notConfigured("OAuthGrants.List")
+	}
+	return m.ListFunc()
+}
+
+// All records the call and invokes AllFunc.
+func (m *OAuthGrantsMock) All(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.OAuthGrant, error] {
+	m.record("All", ctx, options)
+	if m.AllFunc == nil {
+		return notConfiguredSeq[resend.OAuthGrant](notConfigured("OAuthGrants.All"))
+	}
+	return m.AllFunc(ctx, options)
+}
+
+// RevokeWithContext records the call and invokes RevokeWithContextFunc.
+func (m *OAuthGrantsMock) RevokeWithContext(ctx context.Context, oauthGrantId string) (resend.RevokeOAuthGrantResponse, error) {
+	m.record("RevokeWithContext", ctx, oauthGrantId)
+	if m.RevokeWithContextFunc == nil {
+		var r0 resend.RevokeOAuthGrantResponse
+		return r0, notConfigured("OAuthGrants.RevokeWithContext")
+	}
+	return m.RevokeWithContextFunc(ctx, oauthGrantId)
+}
+
+// Revoke records the call and invokes RevokeFunc.
+func (m *OAuthGrantsMock) Revoke(oauthGrantId string) (resend.RevokeOAuthGrantResponse, error) {
+	m.record("Revoke", oauthGrantId)
+	if m.RevokeFunc == nil {
+		var r0 resend.RevokeOAuthGrantResponse
+		return r0, notConfigured("OAuthGrants.Revoke")
+	}
+	return m.RevokeFunc(oauthGrantId)
+}
+
+// SuppressionsMock is a programmable fake of resend.SuppressionsAPI.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type SuppressionsMock struct {
+	Recorder
+
+	SuppressionsBatchMock *SuppressionsBatchMock
+
+	AddFunc               func(params *resend.AddSuppressionRequest) (resend.AddSuppressionResponse, error)
+	AddWithContextFunc    func(ctx context.Context, params *resend.AddSuppressionRequest) (resend.AddSuppressionResponse, error)
+	ListFunc              func(options *resend.ListSuppressionsOptions) (resend.ListSuppressionsResponse, error)
+	ListWithContextFunc   func(ctx context.Context, options *resend.ListSuppressionsOptions) (resend.ListSuppressionsResponse, error)
+	AllFunc               func(ctx context.Context, options *resend.ListSuppressionsOptions) iter.Seq2[resend.SuppressionListEntry, error]
+	GetFunc               func(idOrEmail string) (resend.Suppression, error)
+	GetWithContextFunc    func(ctx context.Context, idOrEmail string) (resend.Suppression, error)
+	RemoveFunc            func(idOrEmail string) (resend.RemoveSuppressionResponse, error)
+	RemoveWithContextFunc func(ctx context.Context, idOrEmail string) (resend.RemoveSuppressionResponse, error)
+}
+
+var _ resend.SuppressionsAPI = (*SuppressionsMock)(nil)
+
+// Batch returns SuppressionsBatchMock, creating it on first use.
+func (m *SuppressionsMock) Batch() resend.SuppressionsBatchSvc {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.SuppressionsBatchMock == nil {
+		m.SuppressionsBatchMock = &SuppressionsBatchMock{}
+	}
+	return m.SuppressionsBatchMock
+}
+
+// Add records the call and invokes AddFunc.
+func (m *SuppressionsMock) Add(params *resend.AddSuppressionRequest) (resend.AddSuppressionResponse, error) {
+	m.record("Add", params)
+	if m.AddFunc == nil {
+		var r0 resend.AddSuppressionResponse
+		return r0, notConfigured("Suppressions.Add")
+	}
+	return m.AddFunc(params)
+}
+
+// AddWithContext records the call and invokes AddWithContextFunc.
+func (m *SuppressionsMock) AddWithContext(ctx context.Context, params *resend.AddSuppressionRequest) (resend.AddSuppressionResponse, error) {
+	m.record("AddWithContext", ctx, params)
+	if m.AddWithContextFunc == nil {
+		var r0 resend.AddSuppressionResponse
+		return r0, notConfigured("Suppressions.AddWithContext")
+	}
+	return m.AddWithContextFunc(ctx, params)
+}
+
+// List records the call and invokes ListFunc.
+func (m *SuppressionsMock) List(options *resend.ListSuppressionsOptions) (resend.ListSuppressionsResponse, error) {
+	m.record("List", options)
+	if m.ListFunc == nil {
+		var r0 resend.ListSuppressionsResponse
+		return r0, notConfigured("Suppressions.List")
+	}
+	return m.ListFunc(options)
+}
+
+// ListWithContext records the call and invokes ListWithContextFunc.
+func (m *SuppressionsMock) ListWithContext(ctx context.Context, options *resend.ListSuppressionsOptions) (resend.ListSuppressionsResponse, error) {
+	m.record("ListWithContext", ctx, options)
+	if m.ListWithContextFunc == nil {
+		var r0 resend.ListSuppressionsResponse
+		return r0, notConfigured("Suppressions.ListWithContext")
+	}
+	return m.ListWithContextFunc(ctx, options)
+}
+
+// All records the call and invokes AllFunc.
+func (m *SuppressionsMock) All(ctx context.Context, options *resend.ListSuppressionsOptions) iter.Seq2[resend.SuppressionListEntry, error] {
+	m.record("All", ctx, options)
+	if m.AllFunc == nil {
+		return notConfiguredSeq[resend.SuppressionListEntry](notConfigured("Suppressions.All"))
+	}
+	return m.AllFunc(ctx, options)
+}
+
+// Get records the call and invokes GetFunc.
+func (m *SuppressionsMock) Get(idOrEmail string) (resend.Suppression, error) {
+	m.record("Get", idOrEmail)
+	if m.GetFunc == nil {
+		var r0 resend.Suppression
+		return r0, notConfigured("Suppressions.Get")
+	}
+	return m.GetFunc(idOrEmail)
+}
+
+// GetWithContext records the call and invokes GetWithContextFunc.
+func (m *SuppressionsMock) GetWithContext(ctx context.Context, idOrEmail string) (resend.Suppression, error) {
+	m.record("GetWithContext", ctx, idOrEmail)
+	if m.GetWithContextFunc == nil {
+		var r0 resend.Suppression
+		return r0, notConfigured("Suppressions.GetWithContext")
+	}
+	return m.GetWithContextFunc(ctx, idOrEmail)
+}
+
+// Remove records the call and invokes RemoveFunc.
+func (m *SuppressionsMock) Remove(idOrEmail string) (resend.RemoveSuppressionResponse, error) {
+	m.record("Remove", idOrEmail)
+	if m.RemoveFunc == nil {
+		var r0 resend.RemoveSuppressionResponse
+		return r0, notConfigured("Suppressions.Remove")
+	}
+	return m.RemoveFunc(idOrEmail)
+}
+
+// RemoveWithContext records the call and invokes RemoveWithContextFunc.
+func (m *SuppressionsMock) RemoveWithContext(ctx context.Context, idOrEmail string) (resend.RemoveSuppressionResponse, error) {
+	m.record("RemoveWithContext", ctx, idOrEmail)
+	if m.RemoveWithContextFunc == nil {
+		var r0 resend.RemoveSuppressionResponse
+		return r0, notConfigured("Suppressions.RemoveWithContext")
+	}
+	return m.RemoveWithContextFunc(ctx, idOrEmail)
+}
+
+// SuppressionsBatchMock is a programmable fake of resend.SuppressionsBatchSvc.
+// Methods call the matching XxxFunc field and return ErrNotConfigured when it is nil.
+type SuppressionsBatchMock struct {
+	Recorder
+
+	AddFunc               func(params *resend.BatchAddSuppressionsRequest) (resend.BatchAddSuppressionsResponse, error)
+	AddWithContextFunc    func(ctx context.Context, params *resend.BatchAddSuppressionsRequest) (resend.BatchAddSuppressionsResponse, error)
+	RemoveFunc            func(params *resend.BatchRemoveSuppressionsRequest) (resend.BatchRemoveSuppressionsResponse, error)
+	RemoveWithContextFunc func(ctx context.Context, params *resend.BatchRemoveSuppressionsRequest) (resend.BatchRemoveSuppressionsResponse, error)
+}
+
+var _ resend.SuppressionsBatchSvc = (*SuppressionsBatchMock)(nil)
+
+// Add records the call and invokes AddFunc.
+func (m *SuppressionsBatchMock) Add(params *resend.BatchAddSuppressionsRequest) (resend.BatchAddSuppressionsResponse, error) {
+	m.record("Add", params)
+	if m.AddFunc == nil {
+		var r0 resend.BatchAddSuppressionsResponse
+		return r0, notConfigured("SuppressionsBatch.Add")
+	}
+	return m.AddFunc(params)
+}
+
+// AddWithContext records the call and invokes AddWithContextFunc.
+func (m *SuppressionsBatchMock) AddWithContext(ctx context.Context, params *resend.BatchAddSuppressionsRequest) (resend.BatchAddSuppressionsResponse, error) {
+	m.record("AddWithContext", ctx, params)
+	if m.AddWithContextFunc == nil {
+		var r0 resend.BatchAddSuppressionsResponse
+		return r0, notConfigured("SuppressionsBatch.AddWithContext")
+	}
+	return m.AddWithContextFunc(ctx, params)
+}
+
+// Remove records the call and invokes RemoveFunc.
+func (m *SuppressionsBatchMock) Remove(params *resend.BatchRemoveSuppressionsRequest) (resend.BatchRemoveSuppressionsResponse, error) {
+	m.record("Remove", params)
+	if m.RemoveFunc == nil {
+		var r0 resend.BatchRemoveSuppressionsResponse
+		return r0, notConfigured("SuppressionsBatch.Remove")
+	}
+	return m.RemoveFunc(params)
+}
+
+// RemoveWithContext records the call and invokes RemoveWithContextFunc.
+func (m *SuppressionsBatchMock) RemoveWithContext(ctx context.Context, params *resend.BatchRemoveSuppressionsRequest) (resend.BatchRemoveSuppressionsResponse, error) {
+	m.record("RemoveWithContext", ctx, params)
+	if m.RemoveWithContextFunc == nil {
+		var r0 resend.BatchRemoveSuppressionsResponse
+		return r0, notConfigured("SuppressionsBatch.RemoveWithContext")
+	}
+	return m.RemoveWithContextFunc(ctx, params)
+}
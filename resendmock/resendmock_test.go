@@ -0,0 +1,90 @@
+package resendmock_test
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"testing"
+
+	"github.com/resend/resend-go/v3"
+	"github.com/resend/resend-go/v3/resendmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// welcome is an example of code under test depending on resend.API
+func welcome(ctx context.Context, api resend.API, email string) (string, error) {
+	_, err := api.Contacts().CreateWithContext(ctx, &resend.CreateContactRequest{Email: email})
+	if err != nil {
+		return "", err
+	}
+	sent, err := api.Emails().SendWithContext(ctx, &resend.SendEmailRequest{
+		From:    "onboarding@resend.dev",
+		To:      []string{email},
+		Subject: "Welcome",
+	})
+	if err != nil {
+		return "", err
+	}
+	return sent.Id, nil
+}
+
+func TestClient(t *testing.T) {
+	mock := resendmock.NewClient()
+	mock.ContactsMock.CreateWithContextFunc = func(ctx context.Context, params *resend.CreateContactRequest) (resend.CreateContactResponse, error) {
+		return resend.CreateContactResponse{Id: "contact_1"}, nil
+	}
+	mock.EmailsMock.SendWithContextFunc = func(ctx context.Context, params *resend.SendEmailRequest) (*resend.SendEmailResponse, error) {
+		return &resend.SendEmailResponse{Id: "email_1"}, nil
+	}
+
+	id, err := welcome(context.Background(), mock, "jane@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "email_1", id)
+
+	assert.Equal(t, 1, mock.ContactsMock.CallCount("CreateWithContext"))
+	calls := mock.EmailsMock.CallsTo("SendWithContext")
+	assert.Len(t, calls, 1)
+	params := calls[0].Args[1].(*resend.SendEmailRequest)
+	assert.Equal(t, []string{"jane@example.com"}, params.To)
+
+	mock.EmailsMock.Reset()
+	assert.Empty(t, mock.EmailsMock.Calls())
+}
+
+func TestNotConfigured(t *testing.T) {
+	mock := resendmock.NewClient()
+
+	_, err := welcome(context.Background(), mock, "jane@example.com")
+	assert.True(t, errors.Is(err, resendmock.ErrNotConfigured))
+	assert.Contains(t, err.Error(), "Contacts.CreateWithContext")
+	assert.Equal(t, 1, mock.ContactsMock.CallCount("CreateWithContext"))
+
+	for _, err := range mock.Domains().All(context.Background(), nil) {
+		assert.True(t, errors.Is(err, resendmock.ErrNotConfigured))
+	}
+}
+
+func TestNestedServices(t *testing.T) {
+	mock := &resendmock.Client{}
+
+	topics := mock.Contacts().Topics()
+	assert.Same(t, mock.ContactsMock.ContactTopicsMock, topics)
+
+	mock = resendmock.NewClient()
+
+	mock.SuppressionsMock.SuppressionsBatchMock.AddFunc = func(params *resend.BatchAddSuppressionsRequest) (resend.BatchAddSuppressionsResponse, error) {
+		return resend.BatchAddSuppressionsResponse{}, nil
+	}
+	_, err := mock.Suppressions().Batch().Add(&resend.BatchAddSuppressionsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, mock.SuppressionsMock.SuppressionsBatchMock.CallCount("Add"))
+
+	mock.EmailsMock.ReceivingMock.AllFunc = func(ctx context.Context, options *resend.ListOptions) iter.Seq2[resend.ListReceivedEmail, error] {
+		return func(yield func(resend.ListReceivedEmail, error) bool) {
+			yield(resend.ListReceivedEmail{Id: "received_1"}, nil)
+		}
+	}
+	emails, err := resend.Collect(mock.Emails().Receiving().All(context.Background(), nil), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "received_1", emails[0].Id)
+}
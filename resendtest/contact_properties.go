@@ -0,0 +1,207 @@
+package resendtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/resend/resend-go/v3"
+)
+
+// propertyKeyPattern matches the keys accepted for contact properties
+var propertyKeyPattern = regexp.MustCompile(`^[a-zA-Z0-9_]{1,50}$`)
+
+// property is a stored contact property
+type property struct {
+	id            string
+	key           string
+	kind          string
+	fallbackValue any
+	createdAt     time.Time
+}
+
+func (p *property) document() resend.ContactProperty {
+	return resend.ContactProperty{
+		Id:            p.id,
+		Key:           p.key,
+		Object:        "contact_property",
+		CreatedAt:     formatTime(p.createdAt),
+		Type:          p.kind,
+		FallbackValue: p.fallbackValue,
+	}
+}
+
+// checkValue returns an error message when v does not match the type of
+// the property. nil is always accepted.
+func (p *property) checkValue(v any) string {
+	if v == nil {
+		return ""
+	}
+	switch p.kind {
+	case "string":
+		if _, ok := v.(string); !ok {
+			return fmt.Sprintf("The `%s` property must be a string.", p.key)
+		}
+	case "number":
+		if _, ok := v.(float64); !ok {
+			return fmt.Sprintf("The `%s` property must be a number.", p.key)
+		}
+	}
+	return ""
+}
+
+// propertyByKey returns the property declared with key
+func (s *Server) propertyByKey(key string) (*property, bool) {
+	for _, p := range s.properties {
+		if p.key == key {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// checkProperties validates the property values of a contact against the
+// declared properties
+func (s *Server) checkProperties(values map[string]any) (int, any, bool) {
+	for key, v := range values {
+		p, ok := s.propertyByKey(key)
+		if !ok {
+			status, doc := validationError(fmt.Sprintf("The `%s` property does not exist.", key))
+			return status, doc, false
+		}
+		if msg := p.checkValue(v); msg != "" {
+			status, doc := validationError(msg)
+			return status, doc, false
+		}
+	}
+	return 0, nil, true
+}
+
+func (s *Server) registerContactProperties() {
+	s.mux.HandleFunc("POST /contact-properties", s.createContactProperty)
+	s.mux.HandleFunc("GET /contact-properties", s.listContactProperties)
+	s.mux.HandleFunc("GET /contact-properties/{id}", s.getContactProperty)
+	s.mux.HandleFunc("PATCH /contact-properties/{id}", s.updateContactProperty)
+	s.mux.HandleFunc("DELETE /contact-properties/{id}", s.removeContactProperty)
+}
+
+func (s *Server) createContactProperty(w http.ResponseWriter, r *http.Request) {
+	var req resend.CreateContactPropertyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "validation_error", "Invalid JSON body: "+err.Error())
+		return
+	}
+	switch {
+	case req.Key == "":
+		writeError(w, http.StatusUnprocessableEntity, "missing_required_field", "Missing `key` field.")
+		return
+	case !propertyKeyPattern.MatchString(req.Key):
+		writeError(w, http.StatusUnprocessableEntity, "validation_error", "The `key` field must contain only letters, numbers and underscores, up to 50 characters.")
+		return
+	case req.Type == "":
+		writeError(w, http.StatusUnprocessableEntity, "missing_required_field", "Missing `type` field.")
+		return
+	case req.Type != "string" && req.Type != "number":
+		writeError(w, http.StatusUnprocessableEntity, "validation_error", "The `type` field must be `string` or `number`.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.propertyByKey(req.Key); ok {
+		writeError(w, http.StatusConflict, "conflict", fmt.Sprintf("A contact property with the key `%s` already exists.", req.Key))
+		return
+	}
+
+	p := &property{id: s.newId(), key: req.Key, kind: req.Type, createdAt: s.now()}
+	if msg := p.checkValue(req.FallbackValue); msg != "" {
+		writeError(w, http.StatusUnprocessableEntity, "validation_error", "The `fallback_value` does not match the property type.")
+		return
+	}
+	p.fallbackValue = req.FallbackValue
+
+	s.properties[p.id] = p
+	s.propertiesOrder = append(s.propertiesOrder, p.id)
+	writeJSON(w, http.StatusCreated, &resend.CreateContactPropertyResponse{Id: p.id, Object: "contact_property"})
+}
+
+func (s *Server) listContactProperties(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	page, hasMore, status, doc := paginate(newestFirst(s.propertiesOrder), r.URL.Query())
+	if status != 0 {
+		writeJSON(w, status, doc)
+		return
+	}
+
+	data := make([]resend.ContactProperty, len(page))
+	for i, id := range page {
+		data[i] = s.properties[id].document()
+	}
+	writeJSON(w, http.StatusOK, &list{Object: "list", HasMore: hasMore, Data: data})
+}
+
+func (s *Server) getContactProperty(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.properties[r.PathValue("id")]
+	if !ok {
+		respond(w)(notFound("Contact property"))
+		return
+	}
+	writeJSON(w, http.StatusOK, p.document())
+}
+
+func (s *Server) updateContactProperty(w http.ResponseWriter, r *http.Request) {
+	var req map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "validation_error", "Invalid JSON body: "+err.Error())
+		return
+	}
+	for _, field := range []string{"key", "type"} {
+		if _, ok := req[field]; ok {
+			writeError(w, http.StatusUnprocessableEntity, "validation_error", fmt.Sprintf("The `%s` field cannot be changed.", field))
+			return
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.properties[r.PathValue("id")]
+	if !ok {
+		respond(w)(notFound("Contact property"))
+		return
+	}
+	if msg := p.checkValue(req["fallback_value"]); msg != "" {
+		writeError(w, http.StatusUnprocessableEntity, "validation_error", "The `fallback_value` does not match the property type.")
+		return
+	}
+	p.fallbackValue = req["fallback_value"]
+	writeJSON(w, http.StatusOK, &resend.UpdateContactPropertyResponse{Id: p.id, Object: "contact_property"})
+}
+
+func (s *Server) removeContactProperty(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	p, ok := s.properties[id]
+	if !ok {
+		respond(w)(notFound("Contact property"))
+		return
+	}
+
+	delete(s.properties, id)
+	s.propertiesOrder = slices.DeleteFunc(s.propertiesOrder, func(v string) bool { return v == id })
+	for _, c := range s.contacts {
+		delete(c.properties, p.key)
+	}
+	writeJSON(w, http.StatusOK, &resend.RemoveContactPropertyResponse{Id: id, Object: "contact_property", Deleted: true})
+}
@@ -0,0 +1,458 @@
+package resendtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/resend/resend-go/v3"
+)
+
+// contact is a stored contact
+type contact struct {
+	id           string
+	email        string
+	firstName    string
+	lastName     string
+	unsubscribed bool
+	properties   map[string]any
+	createdAt    time.Time
+
+	// segments are the ids of the segments of the contact, in the order
+	// the contact was added to them
+	segments []string
+
+	// topics are the explicit subscriptions of the contact by topic id.
+	// Topics missing from the map use their default subscription.
+	topics map[string]resend.TopicSubscription
+}
+
+// Contact returns the contact with the given id or email, as returned by
+// the API.
+func (s *Server) Contact(idOrEmail string) (resend.Contact, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.contact(idOrEmail)
+	if !ok {
+		return resend.Contact{}, false
+	}
+	return s.contactDocument(c), true
+}
+
+// ContactSegments returns the ids of the segments of the contact with the
+// given id or email, in the order the contact was added to them.
+func (s *Server) ContactSegments(idOrEmail string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.contact(idOrEmail)
+	if !ok {
+		return nil
+	}
+	return slices.Clone(c.segments)
+}
+
+// ContactSubscription returns the subscription of the contact with the
+// given id or email to a topic, ie: opt_in. It is the default subscription
+// of the topic unless the contact changed it, and empty when the contact or
+// the topic does not exist.
+func (s *Server) ContactSubscription(idOrEmail, topicId string) resend.TopicSubscription {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.contact(idOrEmail)
+	if !ok {
+		return ""
+	}
+	t, ok := s.topics[topicId]
+	if !ok {
+		return ""
+	}
+	return c.subscription(t)
+}
+
+// contact returns the contact with the given id or email. Emails are
+// matched case-insensitively.
+func (s *Server) contact(idOrEmail string) (*contact, bool) {
+	if c, ok := s.contacts[idOrEmail]; ok {
+		return c, true
+	}
+	if !strings.Contains(idOrEmail, "@") {
+		return nil, false
+	}
+	for _, c := range s.contacts {
+		if strings.EqualFold(c.email, idOrEmail) {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// subscription returns the effective subscription of the contact to t
+func (c *contact) subscription(t *topic) resend.TopicSubscription {
+	if v, ok := c.topics[t.id]; ok {
+		return v
+	}
+	return resend.TopicSubscription(t.defaultSubscription)
+}
+
+// contactDocument returns the document of a contact. Every declared
+// property is included, using its fallback value when the contact has no
+// value for it.
+func (s *Server) contactDocument(c *contact) resend.Contact {
+	doc := resend.Contact{
+		Id:           c.id,
+		Email:        c.email,
+		Object:       "contact",
+		FirstName:    c.firstName,
+		LastName:     c.lastName,
+		CreatedAt:    formatTime(c.createdAt),
+		Unsubscribed: c.unsubscribed,
+	}
+	if len(s.properties) > 0 {
+		doc.Properties = map[string]any{}
+		for _, p := range s.properties {
+			if v, ok := c.properties[p.key]; ok {
+				doc.Properties[p.key] = v
+			} else {
+				doc.Properties[p.key] = p.fallbackValue
+			}
+		}
+	}
+	return doc
+}
+
+func (s *Server) registerContacts() {
+	s.mux.HandleFunc("POST /contacts", s.createContact)
+	s.mux.HandleFunc("GET /contacts", s.listContacts)
+	s.mux.HandleFunc("GET /contacts/{id}", s.getContact)
+	s.mux.HandleFunc("PATCH /contacts/{id}", s.updateContact)
+	s.mux.HandleFunc("DELETE /contacts/{id}", s.removeContact)
+	s.mux.HandleFunc("GET /contacts/{id}/segments", s.listContactSegments)
+	s.mux.HandleFunc("POST /contacts/{id}/segments/{segmentId}", s.addContactSegment)
+	s.mux.HandleFunc("DELETE /contacts/{id}/segments/{segmentId}", s.removeContactSegment)
+	s.mux.HandleFunc("GET /contacts/{id}/topics", s.listContactTopics)
+	s.mux.HandleFunc("PATCH /contacts/{id}/topics", s.updateContactTopics)
+}
+
+func (s *Server) createContact(w http.ResponseWriter, r *http.Request) {
+	var req resend.CreateContactRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "validation_error", "Invalid JSON body: "+err.Error())
+		return
+	}
+	if req.Email == "" {
+		writeError(w, http.StatusUnprocessableEntity, "missing_required_field", "Missing `email` field.")
+		return
+	}
+	if _, err := mail.ParseAddress(req.Email); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "validation_error", "Invalid `email` field.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.contact(req.Email); ok {
+		writeError(w, http.StatusConflict, "conflict", fmt.Sprintf("A contact with the email `%s` already exists.", req.Email))
+		return
+	}
+	if status, doc, ok := s.checkProperties(req.Properties); !ok {
+		writeJSON(w, status, doc)
+		return
+	}
+	for _, ref := range req.Segments {
+		if _, ok := s.segments[ref.Id]; !ok {
+			respond(w)(notFound("Segment"))
+			return
+		}
+	}
+	if status, doc, ok := s.checkSubscriptions(req.Topics); !ok {
+		writeJSON(w, status, doc)
+		return
+	}
+
+	c := &contact{
+		id:           s.newId(),
+		email:        req.Email,
+		firstName:    req.FirstName,
+		lastName:     req.LastName,
+		unsubscribed: req.Unsubscribed,
+		properties:   map[string]any{},
+		createdAt:    s.now(),
+		topics:       map[string]resend.TopicSubscription{},
+	}
+	for key, v := range req.Properties {
+		if v != nil {
+			c.properties[key] = v
+		}
+	}
+	for _, ref := range req.Segments {
+		if !slices.Contains(c.segments, ref.Id) {
+			c.segments = append(c.segments, ref.Id)
+		}
+	}
+	for _, sub := range req.Topics {
+		c.topics[sub.Id] = sub.Subscription
+	}
+
+	s.contacts[c.id] = c
+	s.contactsOrder = append(s.contactsOrder, c.id)
+	writeJSON(w, http.StatusCreated, &resend.CreateContactResponse{Object: "contact", Id: c.id})
+}
+
+func (s *Server) listContacts(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.writeContacts(w, r, s.contactsOrder)
+}
+
+// writeContacts writes the page of contacts selected by the query, ids are
+// ordered by creation. It must be called with the lock held.
+func (s *Server) writeContacts(w http.ResponseWriter, r *http.Request, ids []string) {
+	page, hasMore, status, doc := paginate(newestFirst(ids), r.URL.Query())
+	if status != 0 {
+		writeJSON(w, status, doc)
+		return
+	}
+
+	data := make([]resend.Contact, len(page))
+	for i, id := range page {
+		data[i] = s.contactDocument(s.contacts[id])
+	}
+	writeJSON(w, http.StatusOK, &list{Object: "list", HasMore: hasMore, Data: data})
+}
+
+func (s *Server) getContact(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.contact(r.PathValue("id"))
+	if !ok {
+		respond(w)(notFound("Contact"))
+		return
+	}
+	writeJSON(w, http.StatusOK, s.contactDocument(c))
+}
+
+// updateContactRequest is the body of a contact update. Pointers tell
+// omitted fields apart from zero values.
+type updateContactRequest struct {
+	Email        string         `json:"email"`
+	FirstName    *string        `json:"first_name"`
+	LastName     *string        `json:"last_name"`
+	Unsubscribed *bool          `json:"unsubscribed"`
+	Properties   map[string]any `json:"properties"`
+}
+
+func (s *Server) updateContact(w http.ResponseWriter, r *http.Request) {
+	var req updateContactRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "validation_error", "Invalid JSON body: "+err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.contact(r.PathValue("id"))
+	if !ok {
+		respond(w)(notFound("Contact"))
+		return
+	}
+	if req.Email != "" && !strings.EqualFold(req.Email, c.email) {
+		if _, err := mail.ParseAddress(req.Email); err != nil {
+			writeError(w, http.StatusUnprocessableEntity, "validation_error", "Invalid `email` field.")
+			return
+		}
+		if _, ok := s.contact(req.Email); ok {
+			writeError(w, http.StatusConflict, "conflict", fmt.Sprintf("A contact with the email `%s` already exists.", req.Email))
+			return
+		}
+	}
+	if status, doc, ok := s.checkProperties(req.Properties); !ok {
+		writeJSON(w, status, doc)
+		return
+	}
+
+	if req.Email != "" {
+		c.email = req.Email
+	}
+	if req.FirstName != nil {
+		c.firstName = *req.FirstName
+	}
+	if req.LastName != nil {
+		c.lastName = *req.LastName
+	}
+	if req.Unsubscribed != nil {
+		c.unsubscribed = *req.Unsubscribed
+	}
+	for key, v := range req.Properties {
+		if v == nil {
+			delete(c.properties, key)
+		} else {
+			c.properties[key] = v
+		}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"object": "contact",
+		"id":     c.id,
+		"data":   s.contactDocument(c),
+	})
+}
+
+func (s *Server) removeContact(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.contact(r.PathValue("id"))
+	if !ok {
+		respond(w)(notFound("Contact"))
+		return
+	}
+
+	delete(s.contacts, c.id)
+	s.contactsOrder = slices.DeleteFunc(s.contactsOrder, func(v string) bool { return v == c.id })
+	writeJSON(w, http.StatusOK, &resend.RemoveContactResponse{Id: c.id, Object: "contact", Deleted: true})
+}
+
+func (s *Server) listContactSegments(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.contact(r.PathValue("id"))
+	if !ok {
+		respond(w)(notFound("Contact"))
+		return
+	}
+
+	page, hasMore, status, doc := paginate(newestFirst(c.segments), r.URL.Query())
+	if status != 0 {
+		writeJSON(w, status, doc)
+		return
+	}
+
+	data := make([]resend.Segment, len(page))
+	for i, id := range page {
+		data[i] = s.segments[id].document()
+	}
+	writeJSON(w, http.StatusOK, &list{Object: "list", HasMore: hasMore, Data: data})
+}
+
+func (s *Server) addContactSegment(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.contact(r.PathValue("id"))
+	if !ok {
+		respond(w)(notFound("Contact"))
+		return
+	}
+	segmentId := r.PathValue("segmentId")
+	if _, ok := s.segments[segmentId]; !ok {
+		respond(w)(notFound("Segment"))
+		return
+	}
+
+	if !slices.Contains(c.segments, segmentId) {
+		c.segments = append(c.segments, segmentId)
+	}
+	writeJSON(w, http.StatusCreated, &resend.AddContactSegmentResponse{Id: segmentId, Object: "contact_segment"})
+}
+
+func (s *Server) removeContactSegment(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.contact(r.PathValue("id"))
+	if !ok {
+		respond(w)(notFound("Contact"))
+		return
+	}
+	segmentId := r.PathValue("segmentId")
+	if !slices.Contains(c.segments, segmentId) {
+		respond(w)(notFound("Segment"))
+		return
+	}
+
+	c.segments = slices.DeleteFunc(c.segments, func(v string) bool { return v == segmentId })
+	writeJSON(w, http.StatusOK, &resend.RemoveContactSegmentResponse{Id: segmentId, Object: "contact_segment", Deleted: true})
+}
+
+func (s *Server) listContactTopics(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.contact(r.PathValue("id"))
+	if !ok {
+		respond(w)(notFound("Contact"))
+		return
+	}
+
+	page, hasMore, status, doc := paginate(newestFirst(s.topicsOrder), r.URL.Query())
+	if status != 0 {
+		writeJSON(w, status, doc)
+		return
+	}
+
+	data := make([]resend.ContactTopic, len(page))
+	for i, id := range page {
+		t := s.topics[id]
+		data[i] = resend.ContactTopic{
+			Id:           t.id,
+			Name:         t.name,
+			Description:  t.description,
+			Subscription: c.subscription(t),
+		}
+	}
+	writeJSON(w, http.StatusOK, &list{Object: "list", HasMore: hasMore, Data: data})
+}
+
+func (s *Server) updateContactTopics(w http.ResponseWriter, r *http.Request) {
+	var req []resend.TopicSubscriptionUpdate
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "validation_error", "Invalid JSON body: "+err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.contact(r.PathValue("id"))
+	if !ok {
+		respond(w)(notFound("Contact"))
+		return
+	}
+	if status, doc, ok := s.checkSubscriptions(req); !ok {
+		writeJSON(w, status, doc)
+		return
+	}
+
+	for _, sub := range req {
+		c.topics[sub.Id] = sub.Subscription
+	}
+	writeJSON(w, http.StatusOK, &resend.UpdateContactTopicsResponse{Id: c.id})
+}
+
+// checkSubscriptions validates topic subscriptions, the topics must exist
+// and the subscriptions be opt_in or opt_out
+func (s *Server) checkSubscriptions(subs []resend.TopicSubscriptionUpdate) (int, any, bool) {
+	for _, sub := range subs {
+		if _, ok := s.topics[sub.Id]; !ok {
+			status, doc := notFound("Topic")
+			return status, doc, false
+		}
+		if !sub.Subscription.IsValid() {
+			status, doc := validationError("The `subscription` field must be `opt_in` or `opt_out`.")
+			return status, doc, false
+		}
+	}
+	return 0, nil, true
+}
@@ -0,0 +1,235 @@
+package resendtest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/resend/resend-go/v3"
+	"github.com/resend/resend-go/v3/resendtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateGetUpdateRemoveContact(t *testing.T) {
+	srv := resendtest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	created, err := client.Contacts.Create(&resend.CreateContactRequest{Email: "Jane@Example.com", FirstName: "Jane"})
+	assert.NoError(t, err)
+	assert.Equal(t, "contact", created.Object)
+
+	_, err = client.Contacts.Create(&resend.CreateContactRequest{Email: "jane@example.com"})
+	assert.True(t, errors.Is(err, resend.ErrConflict))
+
+	byEmail, err := client.Contacts.Get(&resend.GetContactOptions{Id: "jane@example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, created.Id, byEmail.Id)
+	assert.Equal(t, "Jane", byEmail.FirstName)
+
+	update := &resend.UpdateContactRequest{Id: created.Id, LastName: "Doe"}
+	update.SetUnsubscribed(true)
+	updated, err := client.Contacts.Update(update)
+	assert.NoError(t, err)
+	assert.Equal(t, "Doe", updated.Data.LastName)
+	assert.True(t, updated.Data.Unsubscribed)
+
+	contact, ok := srv.Contact(created.Id)
+	assert.True(t, ok)
+	assert.Equal(t, "Jane", contact.FirstName)
+	assert.True(t, contact.Unsubscribed)
+
+	removed, err := client.Contacts.Remove(&resend.RemoveContactOptions{Id: "jane@example.com"})
+	assert.NoError(t, err)
+	assert.True(t, removed.Deleted)
+
+	_, err = client.Contacts.Get(&resend.GetContactOptions{Id: created.Id})
+	assert.True(t, errors.Is(err, resend.ErrNotFound))
+}
+
+func TestContactProperties(t *testing.T) {
+	srv := resendtest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	_, err := client.ContactProperties.Create(&resend.CreateContactPropertyRequest{Key: "tier", Type: "string", FallbackValue: "free"})
+	assert.NoError(t, err)
+	_, err = client.ContactProperties.Create(&resend.CreateContactPropertyRequest{Key: "age", Type: "number"})
+	assert.NoError(t, err)
+
+	_, err = client.ContactProperties.Create(&resend.CreateContactPropertyRequest{Key: "tier", Type: "string"})
+	assert.True(t, errors.Is(err, resend.ErrConflict))
+	_, err = client.ContactProperties.Create(&resend.CreateContactPropertyRequest{Key: "bad key", Type: "string"})
+	assert.True(t, errors.Is(err, resend.ErrValidation))
+	_, err = client.ContactProperties.Create(&resend.CreateContactPropertyRequest{Key: "active", Type: "boolean"})
+	assert.True(t, errors.Is(err, resend.ErrValidation))
+	_, err = client.ContactProperties.Create(&resend.CreateContactPropertyRequest{Key: "score", Type: "number", FallbackValue: "high"})
+	assert.True(t, errors.Is(err, resend.ErrValidation))
+
+	_, err = client.Contacts.Create(&resend.CreateContactRequest{Email: "jane@example.com", Properties: map[string]any{"plan": "pro"}})
+	assert.True(t, errors.Is(err, resend.ErrValidation))
+	_, err = client.Contacts.Create(&resend.CreateContactRequest{Email: "jane@example.com", Properties: map[string]any{"age": "30"}})
+	assert.True(t, errors.Is(err, resend.ErrValidation))
+
+	created, err := client.Contacts.Create(&resend.CreateContactRequest{Email: "jane@example.com", Properties: map[string]any{"age": 30}})
+	assert.NoError(t, err)
+
+	contact, err := client.Contacts.Get(&resend.GetContactOptions{Id: created.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"tier": "free", "age": float64(30)}, contact.Properties)
+
+	props, err := resend.Collect(client.ContactProperties.All(context.Background(), nil), 0)
+	assert.NoError(t, err)
+	assert.Len(t, props, 2)
+	assert.Equal(t, "age", props[0].Key)
+
+	_, err = client.ContactProperties.Remove(props[0].Id)
+	assert.NoError(t, err)
+	contact, _ = srv.Contact(created.Id)
+	assert.Equal(t, map[string]any{"tier": "free"}, contact.Properties)
+}
+
+func TestContactSegments(t *testing.T) {
+	srv := resendtest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	segment, err := client.Segments.Create(&resend.CreateSegmentRequest{Name: "Customers"})
+	assert.NoError(t, err)
+
+	_, err = client.Contacts.Create(&resend.CreateContactRequest{Email: "jane@example.com", Segments: []resend.ContactSegmentRef{{Id: "missing"}}})
+	assert.True(t, errors.Is(err, resend.ErrNotFound))
+
+	jane, err := client.Contacts.Create(&resend.CreateContactRequest{Email: "jane@example.com", Segments: []resend.ContactSegmentRef{{Id: segment.Id}}})
+	assert.NoError(t, err)
+	john, err := client.Contacts.Create(&resend.CreateContactRequest{Email: "john@example.com"})
+	assert.NoError(t, err)
+
+	_, err = client.Contacts.Segments.Add(&resend.AddContactSegmentRequest{SegmentId: segment.Id, Email: "john@example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{segment.Id}, srv.ContactSegments(john.Id))
+
+	members, err := client.Contacts.List(&resend.ListContactsOptions{SegmentId: segment.Id})
+	assert.NoError(t, err)
+	assert.Len(t, members.Data, 2)
+
+	segments, err := client.Contacts.Segments.List(&resend.ListContactSegmentsRequest{ContactId: jane.Id})
+	assert.NoError(t, err)
+	assert.Len(t, segments.Data, 1)
+	assert.Equal(t, "Customers", segments.Data[0].Name)
+
+	_, err = client.Contacts.Segments.Remove(&resend.RemoveContactSegmentRequest{SegmentId: segment.Id, ContactId: jane.Id})
+	assert.NoError(t, err)
+	assert.Empty(t, srv.ContactSegments(jane.Id))
+
+	_, err = client.Segments.Remove(segment.Id)
+	assert.NoError(t, err)
+	assert.Empty(t, srv.ContactSegments(john.Id))
+}
+
+func TestContactTopics(t *testing.T) {
+	srv := resendtest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	news, err := client.Topics.Create(&resend.CreateTopicRequest{Name: "News", DefaultSubscription: resend.DefaultSubscriptionOptIn})
+	assert.NoError(t, err)
+	offers, err := client.Topics.Create(&resend.CreateTopicRequest{Name: "Offers", DefaultSubscription: resend.DefaultSubscriptionOptOut})
+	assert.NoError(t, err)
+
+	_, err = client.Topics.Create(&resend.CreateTopicRequest{Name: "Digest", DefaultSubscription: "weekly"})
+	assert.True(t, errors.Is(err, resend.ErrValidation))
+
+	jane, err := client.Contacts.Create(&resend.CreateContactRequest{Email: "jane@example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, resend.TopicSubscriptionOptIn, srv.ContactSubscription(jane.Id, news.Id))
+	assert.Equal(t, resend.TopicSubscriptionOptOut, srv.ContactSubscription(jane.Id, offers.Id))
+
+	_, err = client.Contacts.Topics.Update(&resend.UpdateContactTopicsRequest{
+		Email:  "jane@example.com",
+		Topics: []resend.TopicSubscriptionUpdate{{Id: news.Id, Subscription: "opt_out"}, {Id: offers.Id, Subscription: "opt_in"}},
+	})
+	assert.NoError(t, err)
+
+	_, err = client.Contacts.Topics.Update(&resend.UpdateContactTopicsRequest{
+		Id:     jane.Id,
+		Topics: []resend.TopicSubscriptionUpdate{{Id: "missing", Subscription: "opt_in"}},
+	})
+	assert.True(t, errors.Is(err, resend.ErrNotFound))
+
+	topics, err := client.Contacts.Topics.List(jane.Id)
+	assert.NoError(t, err)
+	assert.Len(t, topics.Data, 2)
+	assert.Equal(t, "Offers", topics.Data[0].Name)
+	assert.Equal(t, resend.TopicSubscriptionOptIn, topics.Data[0].Subscription)
+	assert.Equal(t, resend.TopicSubscriptionOptOut, topics.Data[1].Subscription)
+}
+
+func TestUnsubscriber(t *testing.T) {
+	srv := resendtest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	news, err := client.Topics.Create(&resend.CreateTopicRequest{Name: "News", DefaultSubscription: resend.DefaultSubscriptionOptIn})
+	assert.NoError(t, err)
+	jane, err := client.Contacts.Create(&resend.CreateContactRequest{Email: "jane@example.com"})
+	assert.NoError(t, err)
+
+	unsub, err := resend.NewUnsubscriber(client, &resend.UnsubscribeOptions{Secret: "s3cr3t", BaseURL: "https://example.com/unsubscribe"})
+	assert.NoError(t, err)
+	handler := httptest.NewServer(unsub)
+	defer handler.Close()
+
+	post := func(contact, topicId string) int {
+		link, err := unsub.URL(contact, topicId)
+		assert.NoError(t, err)
+		link = strings.Replace(link, "https://example.com/unsubscribe", handler.URL, 1)
+		resp, err := http.Post(link, "application/x-www-form-urlencoded", strings.NewReader(resend.ListUnsubscribeOneClick))
+		assert.NoError(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, http.StatusOK, post(jane.Id, news.Id))
+	assert.Equal(t, resend.TopicSubscriptionOptOut, srv.ContactSubscription(jane.Id, news.Id))
+	contact, _ := srv.Contact(jane.Id)
+	assert.False(t, contact.Unsubscribed)
+
+	assert.Equal(t, http.StatusOK, post("jane@example.com", ""))
+	contact, _ = srv.Contact(jane.Id)
+	assert.True(t, contact.Unsubscribed)
+
+	assert.Equal(t, http.StatusBadGateway, post("john@example.com", ""))
+}
+
+func TestContactsPagination(t *testing.T) {
+	srv := resendtest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	for i := range 5 {
+		_, err := client.Contacts.Create(&resend.CreateContactRequest{Email: fmt.Sprintf("user%d@example.com", i)})
+		assert.NoError(t, err)
+	}
+
+	limit := 2
+	page, err := client.Contacts.List(&resend.ListContactsOptions{Limit: &limit})
+	assert.NoError(t, err)
+	assert.True(t, page.HasMore)
+	assert.Equal(t, "user4@example.com", page.Data[0].Email)
+
+	var emails []string
+	for contact, err := range client.Contacts.All(context.Background(), &resend.ListContactsOptions{Limit: &limit}) {
+		assert.NoError(t, err)
+		emails = append(emails, contact.Email)
+	}
+	assert.Equal(t, []string{"user4@example.com", "user3@example.com", "user2@example.com", "user1@example.com", "user0@example.com"}, emails)
+
+	tooMany := 101
+	_, err = client.Contacts.List(&resend.ListContactsOptions{Limit: &tooMany})
+	assert.True(t, errors.Is(err, resend.ErrValidation))
+}
@@ -0,0 +1,496 @@
+package resendtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/resend/resend-go/v3"
+)
+
+// maxScheduleHorizon is how far in the future emails can be scheduled
+const maxScheduleHorizon = 30 * 24 * time.Hour
+
+// Message is an email captured by the server.
+type Message struct {
+	// Id is the id returned to the client
+	Id string
+
+	// Request is the email as it was sent by the client
+	Request resend.SendEmailRequest
+
+	// IdempotencyKey is the Idempotency-Key header of the request, if any
+	IdempotencyKey string
+
+	// Batch reports whether the email was sent with /emails/batch
+	Batch bool
+
+	// CreatedAt is the time the server received the email
+	CreatedAt time.Time
+
+	// ScheduledAt is the time the email is scheduled for, zero when it was
+	// sent immediately
+	ScheduledAt time.Time
+
+	// LastEvent is the status of the email, ie: scheduled, sent or delivered
+	LastEvent resend.EmailEvent
+
+	// Events is the history of LastEvent values, oldest first
+	Events []resend.EmailEvent
+}
+
+// eventTransitions are the last_event values an email can move to from
+// each last_event value
+var eventTransitions = map[resend.EmailEvent][]resend.EmailEvent{
+	resend.EmailEventScheduled:       {resend.EmailEventSent, resend.EmailEventCanceled},
+	resend.EmailEventQueued:          {resend.EmailEventSent, resend.EmailEventFailed},
+	resend.EmailEventSent:            {resend.EmailEventDelivered, resend.EmailEventDeliveryDelayed, resend.EmailEventBounced, resend.EmailEventFailed, resend.EmailEventSuppressed},
+	resend.EmailEventDeliveryDelayed: {resend.EmailEventDelivered, resend.EmailEventBounced, resend.EmailEventFailed},
+	resend.EmailEventDelivered:       {resend.EmailEventOpened, resend.EmailEventClicked, resend.EmailEventComplained},
+	resend.EmailEventOpened:          {resend.EmailEventOpened, resend.EmailEventClicked, resend.EmailEventComplained},
+	resend.EmailEventClicked:         {resend.EmailEventOpened, resend.EmailEventClicked, resend.EmailEventComplained},
+}
+
+// Messages returns a copy of every captured email, in the order they were
+// received.
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sendDueEmails()
+
+	messages := make([]Message, 0, len(s.emailsOrder))
+	for _, id := range s.emailsOrder {
+		messages = append(messages, s.emails[id].clone())
+	}
+	return messages
+}
+
+// Message returns a copy of the captured email with the given id
+func (s *Server) Message(id string) (Message, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sendDueEmails()
+
+	m, ok := s.emails[id]
+	if !ok {
+		return Message{}, false
+	}
+	return m.clone(), true
+}
+
+// SetLastEvent moves an email to a new last_event value, simulating the
+// delivery pipeline, ie: sent, then delivered, then opened. It returns an
+// error for unknown emails and for transitions the API cannot make, ie:
+// from bounced to delivered.
+func (s *Server) SetLastEvent(id string, event resend.EmailEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sendDueEmails()
+
+	m, ok := s.emails[id]
+	if !ok {
+		return fmt.Errorf("resendtest: email %s not found", id)
+	}
+	if !slices.Contains(eventTransitions[m.LastEvent], event) {
+		return fmt.Errorf("resendtest: email %s cannot move from %s to %s", id, m.LastEvent, event)
+	}
+	m.setLastEvent(event)
+	return nil
+}
+
+func (m *Message) clone() Message {
+	c := *m
+	c.Events = slices.Clone(m.Events)
+	return c
+}
+
+func (m *Message) setLastEvent(event resend.EmailEvent) {
+	m.LastEvent = event
+	m.Events = append(m.Events, event)
+}
+
+// sendDueEmails marks the scheduled emails whose time has come as sent
+func (s *Server) sendDueEmails() {
+	now := s.now()
+	for _, id := range s.emailsOrder {
+		m := s.emails[id]
+		if m.LastEvent == resend.EmailEventScheduled && !now.Before(m.ScheduledAt) {
+			m.setLastEvent(resend.EmailEventSent)
+		}
+	}
+}
+
+func (s *Server) registerEmails() {
+	s.mux.HandleFunc("POST /emails", s.sendEmail)
+	s.mux.HandleFunc("GET /emails", s.listEmails)
+	s.mux.HandleFunc("POST /emails/batch", s.sendBatch)
+	s.mux.HandleFunc("GET /emails/{id}", s.getEmail)
+	s.mux.HandleFunc("PATCH /emails/{id}", s.updateEmail)
+	s.mux.HandleFunc("POST /emails/{id}/cancel", s.cancelEmail)
+	s.mux.HandleFunc("POST /emails/{id}/share", s.shareEmail)
+}
+
+func (s *Server) sendEmail(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		return
+	}
+
+	s.idempotent(w, r, body, func() (int, any) {
+		var req resend.SendEmailRequest
+		if status, doc, ok := decode(body, &req); !ok {
+			return status, doc
+		}
+		scheduledAt, status, doc := s.validateEmail(&req, false)
+		if status != 0 {
+			return status, doc
+		}
+
+		m := s.captureEmail(req, r.Header.Get("Idempotency-Key"), false, scheduledAt)
+		return http.StatusOK, &resend.SendEmailResponse{Id: m.Id}
+	})
+}
+
+func (s *Server) sendBatch(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		return
+	}
+
+	mode := resend.BatchValidationMode(r.Header.Get("x-batch-validation"))
+	if mode == "" {
+		mode = resend.BatchValidationStrict
+	}
+	if !mode.IsValid() {
+		writeError(w, http.StatusUnprocessableEntity, "validation_error", "The `x-batch-validation` header must be `strict` or `permissive`.")
+		return
+	}
+
+	s.idempotent(w, r, body, func() (int, any) {
+		var reqs []resend.SendEmailRequest
+		if status, doc, ok := decode(body, &reqs); !ok {
+			return status, doc
+		}
+		if len(reqs) == 0 {
+			return validationError("The batch must contain at least 1 email.")
+		}
+		if len(reqs) > 100 {
+			return validationError("The batch can contain at most 100 emails.")
+		}
+
+		var errs []resend.BatchError
+		for i := range reqs {
+			if _, status, doc := s.validateEmail(&reqs[i], true); status != 0 {
+				message := doc.(*apiError).Message
+				if mode == resend.BatchValidationStrict {
+					return validationError(fmt.Sprintf("emails[%d]: %s", i, message))
+				}
+				errs = append(errs, resend.BatchError{Index: i, Message: message})
+			}
+		}
+
+		resp := &resend.BatchEmailResponse{Data: []resend.SendEmailResponse{}}
+		for i, req := range reqs {
+			if slices.ContainsFunc(errs, func(e resend.BatchError) bool { return e.Index == i }) {
+				continue
+			}
+			m := s.captureEmail(req, r.Header.Get("Idempotency-Key"), true, time.Time{})
+			resp.Data = append(resp.Data, resend.SendEmailResponse{Id: m.Id})
+		}
+		resp.Errors = errs
+		return http.StatusOK, resp
+	})
+}
+
+// captureEmail stores a new email
+func (s *Server) captureEmail(req resend.SendEmailRequest, key string, batch bool, scheduledAt time.Time) *Message {
+	m := &Message{
+		Id:             s.newId(),
+		Request:        req,
+		IdempotencyKey: key,
+		Batch:          batch,
+		CreatedAt:      s.now(),
+		ScheduledAt:    scheduledAt,
+	}
+	if scheduledAt.IsZero() {
+		m.setLastEvent(resend.EmailEventSent)
+	} else {
+		m.setLastEvent(resend.EmailEventScheduled)
+	}
+
+	s.emails[m.Id] = m
+	s.emailsOrder = append(s.emailsOrder, m.Id)
+	return m
+}
+
+func (s *Server) listEmails(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sendDueEmails()
+
+	page, hasMore, status, doc := paginate(newestFirst(s.emailsOrder), r.URL.Query())
+	if status != 0 {
+		writeJSON(w, status, doc)
+		return
+	}
+
+	data := make([]*emailDocument, len(page))
+	for i, id := range page {
+		data[i] = s.emails[id].document()
+	}
+	writeJSON(w, http.StatusOK, &list{Object: "list", HasMore: hasMore, Data: data})
+}
+
+func (s *Server) getEmail(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sendDueEmails()
+
+	m, ok := s.emails[r.PathValue("id")]
+	if !ok {
+		respond(w)(notFoundEmail())
+		return
+	}
+	writeJSON(w, http.StatusOK, m.document())
+}
+
+func (s *Server) updateEmail(w http.ResponseWriter, r *http.Request) {
+	var req resend.UpdateEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "validation_error", "Invalid JSON body: "+err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sendDueEmails()
+
+	m, ok := s.emails[r.PathValue("id")]
+	if !ok {
+		respond(w)(notFoundEmail())
+		return
+	}
+	if m.LastEvent != resend.EmailEventScheduled {
+		respond(w)(validationError("Only scheduled emails can be updated."))
+		return
+	}
+	scheduledAt, status, doc := s.parseScheduledAt(req.ScheduledAt)
+	if status != 0 {
+		writeJSON(w, status, doc)
+		return
+	}
+	if scheduledAt.IsZero() {
+		respond(w)(errorResponse(http.StatusUnprocessableEntity, "missing_required_field", "Missing `scheduled_at` field."))
+		return
+	}
+
+	m.ScheduledAt = scheduledAt
+	m.Request.ScheduledAt = req.ScheduledAt
+	writeJSON(w, http.StatusOK, &resend.UpdateEmailResponse{Id: m.Id, Object: "email"})
+}
+
+func (s *Server) cancelEmail(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sendDueEmails()
+
+	m, ok := s.emails[r.PathValue("id")]
+	if !ok {
+		respond(w)(notFoundEmail())
+		return
+	}
+	if m.LastEvent != resend.EmailEventScheduled {
+		respond(w)(validationError("Only scheduled emails can be canceled."))
+		return
+	}
+
+	m.setLastEvent(resend.EmailEventCanceled)
+	writeJSON(w, http.StatusOK, &resend.CancelScheduledEmailResponse{Id: m.Id, Object: "email"})
+}
+
+func (s *Server) shareEmail(w http.ResponseWriter, r *http.Request) {
+	var req resend.ShareEmailRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+			writeError(w, http.StatusUnprocessableEntity, "validation_error", "Invalid JSON body: "+err.Error())
+			return
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m, ok := s.emails[r.PathValue("id")]
+	if !ok {
+		respond(w)(notFoundEmail())
+		return
+	}
+	writeJSON(w, http.StatusOK, &resend.ShareEmailResponse{
+		Id:     m.Id,
+		Object: "email",
+		Url:    "https://resend.com/share/" + m.Id,
+	})
+}
+
+func notFoundEmail() (int, any) {
+	return notFound("Email")
+}
+
+// emailDocument is the document returned for an email
+type emailDocument struct {
+	Object      string            `json:"object"`
+	Id          string            `json:"id"`
+	To          []string          `json:"to"`
+	From        string            `json:"from"`
+	CreatedAt   string            `json:"created_at"`
+	Subject     string            `json:"subject"`
+	Html        *string           `json:"html"`
+	Text        *string           `json:"text"`
+	Bcc         []string          `json:"bcc"`
+	Cc          []string          `json:"cc"`
+	ReplyTo     []string          `json:"reply_to"`
+	LastEvent   resend.EmailEvent `json:"last_event"`
+	ScheduledAt *string           `json:"scheduled_at"`
+	Tags        []resend.Tag      `json:"tags,omitempty"`
+}
+
+func (m *Message) document() *emailDocument {
+	doc := &emailDocument{
+		Object:    "email",
+		Id:        m.Id,
+		To:        m.Request.To,
+		From:      m.Request.From,
+		CreatedAt: formatTime(m.CreatedAt),
+		Subject:   m.Request.Subject,
+		Bcc:       m.Request.Bcc,
+		Cc:        m.Request.Cc,
+		LastEvent: m.LastEvent,
+		Tags:      m.Request.Tags,
+	}
+	if m.Request.Html != "" {
+		doc.Html = &m.Request.Html
+	}
+	if m.Request.Text != "" {
+		doc.Text = &m.Request.Text
+	}
+	if m.Request.ReplyTo != "" {
+		doc.ReplyTo = []string{m.Request.ReplyTo}
+	}
+	if !m.ScheduledAt.IsZero() {
+		scheduledAt := formatTime(m.ScheduledAt)
+		doc.ScheduledAt = &scheduledAt
+	}
+	return doc
+}
+
+// tagRe matches the allowed characters of tag names and values
+var tagRe = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// validateEmail validates an email the way the API does. It returns the
+// parsed scheduled time, or the status and document of the error.
+func (s *Server) validateEmail(req *resend.SendEmailRequest, batch bool) (time.Time, int, any) {
+	missing := func(field string) (time.Time, int, any) {
+		status, doc := errorResponse(http.StatusUnprocessableEntity, "missing_required_field", "Missing `"+field+"` field.")
+		return time.Time{}, status, doc
+	}
+	invalid := func(message string) (time.Time, int, any) {
+		status, doc := validationError(message)
+		return time.Time{}, status, doc
+	}
+
+	switch {
+	case req.From == "":
+		return missing("from")
+	case len(req.To) == 0:
+		return missing("to")
+	case req.Subject == "" && req.Template == nil:
+		return missing("subject")
+	}
+
+	if _, err := mail.ParseAddress(req.From); err != nil {
+		return invalid("Invalid `from` field. The email address needs to follow the `email@example.com` or `Name <email@example.com>` format.")
+	}
+	if len(req.To) > 50 {
+		return invalid("Invalid `to` field. You can only send to up to 50 recipients.")
+	}
+	for _, field := range []struct {
+		name  string
+		addrs []string
+	}{{"to", req.To}, {"cc", req.Cc}, {"bcc", req.Bcc}} {
+		for _, addr := range field.addrs {
+			if _, err := mail.ParseAddress(addr); err != nil {
+				return invalid("Invalid `" + field.name + "` field. The email address needs to follow the `email@example.com` or `Name <email@example.com>` format.")
+			}
+		}
+	}
+	if req.Template != nil && (req.Html != "" || req.Text != "") {
+		return invalid("The `template` field cannot be used with `html` or `text`.")
+	}
+	for _, tag := range req.Tags {
+		if !tagRe.MatchString(tag.Name) || !tagRe.MatchString(tag.Value) {
+			return invalid("Tags should only contain ASCII letters, numbers, underscores, or dashes.")
+		}
+	}
+
+	if batch {
+		if len(req.Attachments) > 0 {
+			return invalid("The `attachments` field is not supported for batch emails.")
+		}
+		if req.ScheduledAt != "" {
+			return invalid("The `scheduled_at` field is not supported for batch emails.")
+		}
+		return time.Time{}, 0, nil
+	}
+
+	return s.parseScheduledAt(req.ScheduledAt)
+}
+
+// relativeScheduleRe matches natural language schedules, ie: in 1 hour
+var relativeScheduleRe = regexp.MustCompile(`^in (\d+) (min|mins|minute|minutes|hour|hours|day|days)$`)
+
+// parseScheduledAt parses an ISO 8601 or natural language schedule. Times
+// in the past are treated as immediate sends.
+func (s *Server) parseScheduledAt(value string) (time.Time, int, any) {
+	if value == "" {
+		return time.Time{}, 0, nil
+	}
+
+	now := s.now()
+	var t time.Time
+	if m := relativeScheduleRe.FindStringSubmatch(strings.ToLower(strings.TrimSpace(value))); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		unit := time.Minute
+		switch {
+		case strings.HasPrefix(m[2], "hour"):
+			unit = time.Hour
+		case strings.HasPrefix(m[2], "day"):
+			unit = 24 * time.Hour
+		}
+		t = now.Add(time.Duration(n) * unit)
+	} else {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			status, doc := validationError("Invalid `scheduled_at` field. Use an ISO 8601 date or natural language, ie: in 1 hour.")
+			return time.Time{}, status, doc
+		}
+		t = parsed
+	}
+
+	if t.Sub(now) > maxScheduleHorizon {
+		status, doc := validationError("Invalid `scheduled_at` field. Emails can only be scheduled up to 30 days in advance.")
+		return time.Time{}, status, doc
+	}
+	if !t.After(now) {
+		return time.Time{}, 0, nil
+	}
+	return t, 0, nil
+}
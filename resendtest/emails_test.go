@@ -0,0 +1,206 @@
+package resendtest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/resend/resend-go/v3"
+	"github.com/resend/resend-go/v3/resendtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func testEmail(to string) *resend.SendEmailRequest {
+	return &resend.SendEmailRequest{
+		From:    "Acme <onboarding@resend.dev>",
+		To:      []string{to},
+		Subject: "Hello",
+		Html:    "<p>Hello</p>",
+	}
+}
+
+func TestSendAndGetEmail(t *testing.T) {
+	srv := resendtest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	params := testEmail("jane@example.com")
+	params.Attachments = []*resend.Attachment{{Filename: "invoice.pdf", Content: []byte("%PDF")}}
+	sent, err := client.Emails.Send(params)
+	assert.NoError(t, err)
+
+	email, err := client.Emails.Get(sent.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, sent.Id, email.Id)
+	assert.Equal(t, "email", email.Object)
+	assert.Equal(t, []string{"jane@example.com"}, email.To)
+	assert.Equal(t, resend.EmailEventSent, email.LastEvent)
+	assert.NotEmpty(t, email.CreatedAt)
+
+	msg, ok := srv.Message(sent.Id)
+	assert.True(t, ok)
+	assert.Equal(t, "Hello", msg.Request.Subject)
+	assert.Equal(t, []byte("%PDF"), msg.Request.Attachments[0].Content)
+	assert.Len(t, srv.Messages(), 1)
+
+	_, err = client.Emails.Get("missing")
+	assert.True(t, errors.Is(err, resend.ErrNotFound))
+}
+
+func TestSendEmailValidation(t *testing.T) {
+	srv := resendtest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	_, err := client.Emails.Send(&resend.SendEmailRequest{To: []string{"jane@example.com"}, Subject: "Hello"})
+	var apiErr *resend.APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "missing_required_field", apiErr.Name)
+	assert.True(t, errors.Is(err, resend.ErrValidation))
+
+	params := testEmail("not an address")
+	_, err = client.Emails.Send(params)
+	assert.True(t, errors.Is(err, resend.ErrValidation))
+	assert.Empty(t, srv.Messages())
+}
+
+func TestListEmails(t *testing.T) {
+	srv := resendtest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	var ids []string
+	for range 5 {
+		sent, err := client.Emails.Send(testEmail("jane@example.com"))
+		assert.NoError(t, err)
+		ids = append([]string{sent.Id}, ids...)
+	}
+
+	limit := 2
+	page, err := client.Emails.ListWithOptions(context.Background(), &resend.ListOptions{Limit: &limit})
+	assert.NoError(t, err)
+	assert.True(t, page.HasMore)
+	assert.Equal(t, ids[0], page.Data[0].Id)
+
+	emails, err := resend.Collect(client.Emails.All(context.Background(), &resend.ListOptions{Limit: &limit}), 0)
+	assert.NoError(t, err)
+	var got []string
+	for _, e := range emails {
+		got = append(got, e.Id)
+	}
+	assert.Equal(t, ids, got)
+}
+
+func TestScheduledEmails(t *testing.T) {
+	srv := resendtest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	srv.SetClock(func() time.Time { return now })
+
+	params := testEmail("jane@example.com")
+	params.ScheduledAt = "in 1 hour"
+	first, err := client.Emails.Send(params)
+	assert.NoError(t, err)
+
+	params.ScheduledAt = now.Add(2 * time.Hour).Format(time.RFC3339)
+	second, err := client.Emails.Send(params)
+	assert.NoError(t, err)
+
+	email, err := client.Emails.Get(first.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, resend.EmailEventScheduled, email.LastEvent)
+
+	// Reschedule then cancel the second email
+	_, err = client.Emails.Update(&resend.UpdateEmailRequest{Id: second.Id, ScheduledAt: now.Add(3 * time.Hour).Format(time.RFC3339)})
+	assert.NoError(t, err)
+	msg, _ := srv.Message(second.Id)
+	assert.Equal(t, now.Add(3*time.Hour), msg.ScheduledAt)
+
+	_, err = client.Emails.Cancel(second.Id)
+	assert.NoError(t, err)
+	_, err = client.Emails.Cancel(second.Id)
+	assert.True(t, errors.Is(err, resend.ErrValidation))
+
+	// The first email is sent once it is due
+	now = now.Add(time.Hour)
+	email, err = client.Emails.Get(first.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, resend.EmailEventSent, email.LastEvent)
+
+	_, err = client.Emails.Update(&resend.UpdateEmailRequest{Id: first.Id, ScheduledAt: "in 1 hour"})
+	assert.True(t, errors.Is(err, resend.ErrValidation))
+
+	msg, _ = srv.Message(second.Id)
+	assert.Equal(t, []resend.EmailEvent{resend.EmailEventScheduled, resend.EmailEventCanceled}, msg.Events)
+
+	// Emails cannot be scheduled more than 30 days ahead
+	params.ScheduledAt = "in 31 days"
+	_, err = client.Emails.Send(params)
+	assert.True(t, errors.Is(err, resend.ErrValidation))
+}
+
+func TestSetLastEvent(t *testing.T) {
+	srv := resendtest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	sent, err := client.Emails.Send(testEmail("jane@example.com"))
+	assert.NoError(t, err)
+
+	assert.NoError(t, srv.SetLastEvent(sent.Id, "delivered"))
+	assert.NoError(t, srv.SetLastEvent(sent.Id, "opened"))
+	assert.Error(t, srv.SetLastEvent(sent.Id, "bounced"))
+	assert.Error(t, srv.SetLastEvent("missing", "delivered"))
+
+	email, err := client.Emails.Get(sent.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, resend.EmailEventOpened, email.LastEvent)
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	srv := resendtest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+
+	options := &resend.SendEmailOptions{IdempotencyKey: "welcome/jane"}
+	first, err := client.Emails.SendWithOptions(ctx, testEmail("jane@example.com"), options)
+	assert.NoError(t, err)
+	replay, err := client.Emails.SendWithOptions(ctx, testEmail("jane@example.com"), options)
+	assert.NoError(t, err)
+	assert.Equal(t, first.Id, replay.Id)
+	assert.Len(t, srv.Messages(), 1)
+	assert.Equal(t, "welcome/jane", srv.Messages()[0].IdempotencyKey)
+
+	_, err = client.Emails.SendWithOptions(ctx, testEmail("john@example.com"), options)
+	assert.True(t, errors.Is(err, resend.ErrConflict))
+}
+
+func TestSendBatch(t *testing.T) {
+	srv := resendtest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+
+	invalid := testEmail("jane@example.com")
+	invalid.ScheduledAt = "in 1 hour"
+	batch := []*resend.SendEmailRequest{testEmail("jane@example.com"), invalid, testEmail("john@example.com")}
+
+	_, err := client.Batch.SendWithContext(ctx, batch)
+	assert.True(t, errors.Is(err, resend.ErrValidation))
+	assert.Contains(t, err.Error(), "emails[1]")
+	assert.Empty(t, srv.Messages())
+
+	resp, err := client.Batch.SendWithOptions(ctx, batch, &resend.BatchSendEmailOptions{BatchValidation: resend.BatchValidationPermissive})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Data, 2)
+	assert.Equal(t, []resend.BatchError{{Index: 1, Message: "The `scheduled_at` field is not supported for batch emails."}}, resp.Errors)
+
+	messages := srv.Messages()
+	assert.Len(t, messages, 2)
+	assert.True(t, messages[0].Batch)
+	assert.Equal(t, []string{"john@example.com"}, messages[1].Request.To)
+}
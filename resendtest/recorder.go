@@ -0,0 +1,374 @@
+package resendtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Redacted replaces the scrubbed headers and fields in cassettes
+const Redacted = "[REDACTED]"
+
+// ErrNoInteraction is returned by a replaying Recorder when no recorded
+// interaction matches a request
+var ErrNoInteraction = errors.New("[ERROR]: no recorded interaction matches the request")
+
+// Mode is the mode of a Recorder
+type Mode int
+
+const (
+	// ModeAuto replays the cassette when it exists and records it otherwise
+	ModeAuto Mode = iota
+
+	// ModeReplay replays the cassette and never sends requests
+	ModeReplay
+
+	// ModeRecord sends every request and overwrites the cassette
+	ModeRecord
+)
+
+// RecorderOptions configures a Recorder
+type RecorderOptions struct {
+	// Mode selects between recording and replaying, ModeAuto by default
+	Mode Mode
+
+	// Transport sends the requests while recording, http.DefaultTransport
+	// by default
+	Transport http.RoundTripper
+
+	// ScrubHeaders are the request and response headers replaced with
+	// Redacted in the cassette. Authorization is always scrubbed.
+	ScrubHeaders []string
+
+	// ScrubFields are the JSON object fields replaced with Redacted, at any
+	// depth of the request and response bodies, ie: "email" or "token"
+	ScrubFields []string
+}
+
+// Recorder is an http.RoundTripper recording request and response pairs to
+// a cassette file and replaying them later, so a flow captured once against
+// a sandbox account can run offline:
+//
+//	rec, err := resendtest.NewRecorder("testdata/send_email.json", nil)
+//	defer rec.Stop()
+//
+//	client := resend.NewCustomClient(&http.Client{Transport: rec}, os.Getenv("RESEND_API_KEY"))
+//
+// Requests are matched on their method, path, query and JSON body, ignoring
+// the host, the headers, the order of the object keys and the scrubbed
+// fields. Matching interactions are replayed in the order they were recorded.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	headers   []string
+	fields    []string
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	used         []bool
+}
+
+// Cassette is the document stored in a cassette file
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and response pair
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request stored in a cassette
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Query  string      `json:"query,omitempty"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response stored in a cassette
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// NewRecorder returns a Recorder using the cassette file at path. In
+// replay mode the cassette must exist.
+func NewRecorder(path string, opts *RecorderOptions) (*Recorder, error) {
+	if opts == nil {
+		opts = &RecorderOptions{}
+	}
+
+	r := &Recorder{
+		path:      path,
+		mode:      opts.Mode,
+		transport: opts.Transport,
+		headers:   append([]string{"Authorization"}, opts.ScrubHeaders...),
+		fields:    opts.ScrubFields,
+	}
+	if r.transport == nil {
+		r.transport = http.DefaultTransport
+	}
+
+	if r.mode == ModeAuto {
+		r.mode = ModeRecord
+		if _, err := os.Stat(path); err == nil {
+			r.mode = ModeReplay
+		}
+	}
+
+	if r.mode == ModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR]: Failed to read cassette: %w", err)
+		}
+		var cassette Cassette
+		if err := json.Unmarshal(data, &cassette); err != nil {
+			return nil, fmt.Errorf("[ERROR]: Failed to decode cassette %s: %w", path, err)
+		}
+		r.interactions = cassette.Interactions
+		r.used = make([]bool, len(r.interactions))
+	}
+
+	return r, nil
+}
+
+// Recording reports whether the recorder sends the requests and records
+// them, rather than replaying the cassette
+func (r *Recorder) Recording() bool {
+	return r.mode == ModeRecord
+}
+
+// Interactions returns the recorded interactions
+func (r *Recorder) Interactions() []*Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.interactions)
+}
+
+// Stop writes the cassette when recording. It does nothing when replaying.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := json.MarshalIndent(&Cassette{Interactions: r.interactions}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("[ERROR]: Failed to encode cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("[ERROR]: Failed to write cassette: %w", err)
+	}
+	if err := os.WriteFile(r.path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("[ERROR]: Failed to write cassette: %w", err)
+	}
+	return nil
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	recorded := RecordedRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.RawQuery,
+		Header: r.scrubHeader(req.Header),
+		Body:   r.scrubBody(body),
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, &recorded)
+	}
+	return r.record(req, body, &recorded)
+}
+
+// record sends the request and stores the interaction
+func (r *Recorder) record(req *http.Request, body []byte, recorded *RecordedRequest) (*http.Response, error) {
+	out := req.Clone(req.Context())
+	out.Body = io.NopCloser(bytes.NewReader(body))
+	out.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	out.ContentLength = int64(len(body))
+
+	resp, err := r.transport.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, &Interaction{
+		Request: *recorded,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     r.scrubHeader(resp.Header),
+			Body:       r.scrubBody(data),
+		},
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// replay returns the response of the first unused interaction matching the
+// request. Once every match was used, the last one is replayed again.
+func (r *Recorder) replay(req *http.Request, recorded *RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	last := -1
+	for i, in := range r.interactions {
+		if !r.matches(&in.Request, recorded) {
+			continue
+		}
+		last = i
+		if !r.used[i] {
+			break
+		}
+	}
+	if last < 0 {
+		return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, req.Method, req.URL.RequestURI())
+	}
+	r.used[last] = true
+
+	rec := r.interactions[last].Response
+	header := rec.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", rec.StatusCode, http.StatusText(rec.StatusCode)),
+		StatusCode:    rec.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(rec.Body)),
+		ContentLength: int64(len(rec.Body)),
+		Request:       req,
+	}, nil
+}
+
+// matches reports whether a recorded request matches an incoming one
+func (r *Recorder) matches(a, b *RecordedRequest) bool {
+	if a.Method != b.Method || a.Path != b.Path {
+		return false
+	}
+	qa, err := url.ParseQuery(a.Query)
+	if err != nil {
+		return false
+	}
+	qb, err := url.ParseQuery(b.Query)
+	if err != nil {
+		return false
+	}
+	if len(qa) != len(qb) {
+		return false
+	}
+	for k, v := range qa {
+		if !slices.Equal(v, qb[k]) {
+			return false
+		}
+	}
+	return normalizeJSON(a.Body) == normalizeJSON(b.Body)
+}
+
+// scrubHeader returns a copy of h with the scrubbed headers redacted
+func (r *Recorder) scrubHeader(h http.Header) http.Header {
+	if len(h) == 0 {
+		return nil
+	}
+	h = h.Clone()
+	for _, name := range r.headers {
+		if h.Get(name) != "" {
+			h.Set(name, Redacted)
+		}
+	}
+	return h
+}
+
+// scrubBody returns body with the scrubbed fields redacted. Bodies which
+// are not JSON are returned unchanged.
+func (r *Recorder) scrubBody(body []byte) string {
+	if len(r.fields) == 0 || len(body) == 0 {
+		return string(body)
+	}
+
+	var v any
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return string(body)
+	}
+	data, err := json.Marshal(r.scrubValue(v))
+	if err != nil {
+		return string(body)
+	}
+	return string(data)
+}
+
+func (r *Recorder) scrubValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, field := range v {
+			if slices.Contains(r.fields, k) {
+				v[k] = Redacted
+			} else {
+				v[k] = r.scrubValue(field)
+			}
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = r.scrubValue(item)
+		}
+	}
+	return v
+}
+
+// normalizeJSON returns the canonical encoding of a JSON body, with sorted
+// object keys and no insignificant whitespace. Bodies which are not JSON
+// are returned unchanged.
+func normalizeJSON(body string) string {
+	var v any
+	dec := json.NewDecoder(strings.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return body
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return string(data)
+}
@@ -0,0 +1,100 @@
+package resendtest_test
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/resend/resend-go/v3"
+	"github.com/resend/resend-go/v3/resendtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassettes", "contacts.json")
+	opts := &resendtest.RecorderOptions{ScrubFields: []string{"email"}}
+
+	srv := resendtest.NewServer()
+	rec, err := resendtest.NewRecorder(path, opts)
+	assert.NoError(t, err)
+	assert.True(t, rec.Recording())
+
+	client := resend.NewCustomClient(&http.Client{Transport: rec}, "re_secret")
+	client.BaseURL = srv.Client().BaseURL
+
+	created, err := client.Contacts.Create(&resend.CreateContactRequest{Email: "jane@example.com", FirstName: "Jane"})
+	assert.NoError(t, err)
+	limit := 10
+	_, err = client.Contacts.List(&resend.ListContactsOptions{Limit: &limit})
+	assert.NoError(t, err)
+	_, err = client.Contacts.Get(&resend.GetContactOptions{Id: "missing"})
+	assert.True(t, errors.Is(err, resend.ErrNotFound))
+
+	assert.NoError(t, rec.Stop())
+	srv.Close()
+
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "re_secret")
+	assert.NotContains(t, string(data), "jane@example.com")
+	assert.Contains(t, string(data), resendtest.Redacted)
+
+	// the cassette exists, so the recorder replays it without a server
+	rec, err = resendtest.NewRecorder(path, opts)
+	assert.NoError(t, err)
+	assert.False(t, rec.Recording())
+
+	client = resend.NewCustomClient(&http.Client{Transport: rec}, "re_other")
+
+	// keys are matched regardless of their order
+	replayed, err := client.Contacts.Create(&resend.CreateContactRequest{FirstName: "Jane", Email: "john@example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, created.Id, replayed.Id)
+
+	list, err := client.Contacts.List(&resend.ListContactsOptions{Limit: &limit})
+	assert.NoError(t, err)
+	assert.Len(t, list.Data, 1)
+	assert.Equal(t, resendtest.Redacted, list.Data[0].Email)
+
+	_, err = client.Contacts.Get(&resend.GetContactOptions{Id: "missing"})
+	assert.True(t, errors.Is(err, resend.ErrNotFound))
+
+	_, err = client.Contacts.List(nil)
+	assert.True(t, errors.Is(err, resendtest.ErrNoInteraction))
+	assert.True(t, strings.Contains(err.Error(), "GET /contacts"))
+}
+
+func TestRecorderReplayInOrder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "emails.json")
+
+	srv := resendtest.NewServer()
+	defer srv.Close()
+	rec, err := resendtest.NewRecorder(path, &resendtest.RecorderOptions{Mode: resendtest.ModeRecord})
+	assert.NoError(t, err)
+	client := srv.Client(resend.WithHTTPClient(&http.Client{Transport: rec}))
+
+	first, err := client.Emails.Send(testEmail("jane@example.com"))
+	assert.NoError(t, err)
+	second, err := client.Emails.Send(testEmail("jane@example.com"))
+	assert.NoError(t, err)
+	assert.NoError(t, rec.Stop())
+
+	rec, err = resendtest.NewRecorder(path, &resendtest.RecorderOptions{Mode: resendtest.ModeReplay})
+	assert.NoError(t, err)
+	assert.Len(t, rec.Interactions(), 2)
+	client = resend.NewCustomClient(&http.Client{Transport: rec}, "re_test")
+
+	for _, want := range []string{first.Id, second.Id, second.Id} {
+		sent, err := client.Emails.Send(testEmail("jane@example.com"))
+		assert.NoError(t, err)
+		assert.Equal(t, want, sent.Id)
+	}
+}
+
+func TestRecorderMissingCassette(t *testing.T) {
+	_, err := resendtest.NewRecorder(filepath.Join(t.TempDir(), "missing.json"), &resendtest.RecorderOptions{Mode: resendtest.ModeReplay})
+	assert.Error(t, err)
+}
@@ -0,0 +1,121 @@
+package resendtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/resend/resend-go/v3"
+)
+
+// segment is a stored segment
+type segment struct {
+	id        string
+	name      string
+	createdAt time.Time
+}
+
+func (sg *segment) document() resend.Segment {
+	return resend.Segment{
+		Id:        sg.id,
+		Name:      sg.name,
+		Object:    "segment",
+		CreatedAt: formatTime(sg.createdAt),
+	}
+}
+
+func (s *Server) registerSegments() {
+	s.mux.HandleFunc("POST /segments", s.createSegment)
+	s.mux.HandleFunc("GET /segments", s.listSegments)
+	s.mux.HandleFunc("GET /segments/{id}", s.getSegment)
+	s.mux.HandleFunc("DELETE /segments/{id}", s.removeSegment)
+	s.mux.HandleFunc("GET /segments/{id}/contacts", s.listSegmentContacts)
+}
+
+func (s *Server) createSegment(w http.ResponseWriter, r *http.Request) {
+	var req resend.CreateSegmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "validation_error", "Invalid JSON body: "+err.Error())
+		return
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		writeError(w, http.StatusUnprocessableEntity, "missing_required_field", "Missing `name` field.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sg := &segment{id: s.newId(), name: req.Name, createdAt: s.now()}
+	s.segments[sg.id] = sg
+	s.segmentsOrder = append(s.segmentsOrder, sg.id)
+	writeJSON(w, http.StatusCreated, &resend.CreateSegmentResponse{Id: sg.id, Name: sg.name, Object: "segment"})
+}
+
+func (s *Server) listSegments(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	page, hasMore, status, doc := paginate(newestFirst(s.segmentsOrder), r.URL.Query())
+	if status != 0 {
+		writeJSON(w, status, doc)
+		return
+	}
+
+	data := make([]resend.Segment, len(page))
+	for i, id := range page {
+		data[i] = s.segments[id].document()
+	}
+	writeJSON(w, http.StatusOK, &list{Object: "list", HasMore: hasMore, Data: data})
+}
+
+func (s *Server) getSegment(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sg, ok := s.segments[r.PathValue("id")]
+	if !ok {
+		respond(w)(notFound("Segment"))
+		return
+	}
+	writeJSON(w, http.StatusOK, sg.document())
+}
+
+func (s *Server) removeSegment(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := s.segments[id]; !ok {
+		respond(w)(notFound("Segment"))
+		return
+	}
+
+	delete(s.segments, id)
+	s.segmentsOrder = slices.DeleteFunc(s.segmentsOrder, func(v string) bool { return v == id })
+	for _, c := range s.contacts {
+		c.segments = slices.DeleteFunc(c.segments, func(v string) bool { return v == id })
+	}
+	writeJSON(w, http.StatusOK, &resend.RemoveSegmentResponse{Id: id, Object: "segment", Deleted: true})
+}
+
+func (s *Server) listSegmentContacts(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := s.segments[id]; !ok {
+		respond(w)(notFound("Segment"))
+		return
+	}
+
+	var members []string
+	for _, cid := range s.contactsOrder {
+		if slices.Contains(s.contacts[cid].segments, id) {
+			members = append(members, cid)
+		}
+	}
+	s.writeContacts(w, r, members)
+}
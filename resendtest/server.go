@@ -0,0 +1,363 @@
+// Package resendtest provides an in-memory fake of the Resend API for
+// integration tests.
+//
+// The fake keeps realistic state and answers with the same JSON documents
+// and errors as the API, so a resend.Client pointed at it behaves as it
+// would in production:
+//
+//	srv := resendtest.NewServer()
+//	defer srv.Close()
+//
+//	client := srv.Client()
+//	sent, err := client.Emails.Send(params)
+//
+//	msg, _ := srv.Message(sent.Id)
+package resendtest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/resend/resend-go/v3"
+)
+
+// timeFormat is the format of the timestamps returned by the API
+const timeFormat = "2006-01-02 15:04:05.000000-07"
+
+// Server is a fake Resend API server.
+type Server struct {
+	// URL is the base URL of the server, ie: http://127.0.0.1:1234/
+	URL string
+
+	srv *httptest.Server
+	mux *http.ServeMux
+
+	mu     sync.Mutex
+	now    func() time.Time
+	nextId int
+
+	faults      []*Fault
+	idempotency map[string]*idempotentResponse
+
+	emails      map[string]*Message
+	emailsOrder []string
+
+	contacts      map[string]*contact
+	contactsOrder []string
+
+	segments      map[string]*segment
+	segmentsOrder []string
+
+	topics      map[string]*topic
+	topicsOrder []string
+
+	properties      map[string]*property
+	propertiesOrder []string
+}
+
+// Fault is an error response injected with InjectFault.
+type Fault struct {
+	// Method restricts the fault to requests with this method, ie: POST.
+	// All methods are matched when empty.
+	Method string
+
+	// Path restricts the fault to requests with this path or a path below
+	// it, ie: /emails. All paths are matched when empty.
+	Path string
+
+	// StatusCode is the status of the error response, ie: 429 or 500. A 409
+	// is the conflict of concurrent requests with the same idempotency key.
+	StatusCode int
+
+	// Times is the number of requests failing before the fault is removed.
+	// The fault is permanent when 0.
+	Times int
+
+	// RetryAfter is the retry-after header of 429 responses
+	RetryAfter time.Duration
+}
+
+// idempotentResponse is the response stored for an Idempotency-Key
+type idempotentResponse struct {
+	hash   string
+	status int
+	body   []byte
+}
+
+// NewServer starts and returns a new fake server.
+// It should be closed with Close when done.
+func NewServer() *Server {
+	s := &Server{
+		mux:         http.NewServeMux(),
+		now:         time.Now,
+		idempotency: map[string]*idempotentResponse{},
+		emails:      map[string]*Message{},
+		contacts:    map[string]*contact{},
+		segments:    map[string]*segment{},
+		topics:      map[string]*topic{},
+		properties:  map[string]*property{},
+	}
+	s.registerEmails()
+	s.registerContacts()
+	s.registerSegments()
+	s.registerTopics()
+	s.registerContactProperties()
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.srv.URL + "/"
+	return s
+}
+
+// Close shuts down the server
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns a resend.Client sending its requests to the server. The
+// options are applied after the base URL is set; like with resend.NewClient,
+// an invalid option fails every request of the client.
+func (s *Server) Client(opts ...resend.ClientOption) *resend.Client {
+	return resend.NewClient("re_test", append([]resend.ClientOption{resend.WithBaseURL(s.URL)}, opts...)...)
+}
+
+// SetClock replaces the clock of the server, used for creation timestamps
+// and to send scheduled emails once they are due.
+func (s *Server) SetClock(now func() time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = now
+}
+
+// InjectFault makes the matching requests fail with the fault status code
+// until the fault is exhausted or ClearFaults is called. Faults are matched
+// in the order they were injected.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes every injected fault
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// serveHTTP authenticates the request and applies the injected faults
+// before routing it.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") || r.Header.Get("Authorization") == "Bearer " {
+		writeError(w, http.StatusUnauthorized, "missing_api_key", "Missing API key in the authorization header.")
+		return
+	}
+
+	if f := s.fault(r); f != nil {
+		if f.StatusCode == http.StatusTooManyRequests {
+			w.Header().Set("ratelimit-limit", "2")
+			w.Header().Set("ratelimit-remaining", "0")
+			w.Header().Set("ratelimit-reset", "1")
+			w.Header().Set("retry-after", strconv.Itoa(int(f.RetryAfter/time.Second)))
+			writeError(w, f.StatusCode, "rate_limit_exceeded", "Too many requests. You can only make 2 requests per second.")
+			return
+		}
+		if f.StatusCode == http.StatusConflict {
+			writeError(w, f.StatusCode, "concurrent_idempotent_requests", "Same idempotency key used while original request is still in progress.")
+			return
+		}
+		writeError(w, f.StatusCode, "internal_server_error", "An unexpected error occurred.")
+		return
+	}
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// fault returns the first fault matching the request, consuming it
+func (s *Server) fault(r *http.Request) *Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, f := range s.faults {
+		if f.Method != "" && f.Method != r.Method {
+			continue
+		}
+		if f.Path != "" && r.URL.Path != f.Path && !strings.HasPrefix(r.URL.Path, strings.TrimSuffix(f.Path, "/")+"/") {
+			continue
+		}
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = slices.Delete(s.faults, i, i+1)
+			}
+		}
+		return f
+	}
+	return nil
+}
+
+// newId returns a new unique resource id
+func (s *Server) newId() string {
+	s.nextId++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", s.nextId)
+}
+
+// idempotent serves a POST request honoring its Idempotency-Key header:
+// the first response for a key is stored and replayed for requests with the
+// same key and body, while a different body is rejected. handle is called
+// with the lock held and returns the status and document to respond with.
+func (s *Server) idempotent(w http.ResponseWriter, r *http.Request, body []byte, handle func() (int, any)) {
+	key := r.Header.Get("Idempotency-Key")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if key == "" {
+		status, v := handle()
+		writeJSON(w, status, v)
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	hash := hex.EncodeToString(sum[:])
+	scope := r.URL.Path + " " + key
+
+	if stored, ok := s.idempotency[scope]; ok {
+		if stored.hash != hash {
+			writeError(w, http.StatusConflict, "invalid_idempotent_request", "Same idempotency key used with a different request payload.")
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(stored.status)
+		w.Write(stored.body)
+		return
+	}
+
+	status, v := handle()
+	data, _ := json.Marshal(v)
+	if status < 500 {
+		s.idempotency[scope] = &idempotentResponse{hash: hash, status: status, body: data}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(data)
+}
+
+// apiError is the error document of the API
+type apiError struct {
+	StatusCode int    `json:"statusCode"`
+	Name       string `json:"name"`
+	Message    string `json:"message"`
+}
+
+// errorResponse returns the status and document of an API error
+func errorResponse(status int, name, message string) (int, any) {
+	return status, &apiError{StatusCode: status, Name: name, Message: message}
+}
+
+// validationError returns the status and document of a validation error
+func validationError(message string) (int, any) {
+	return errorResponse(http.StatusUnprocessableEntity, "validation_error", message)
+}
+
+// notFound returns the status and document of a missing resource error
+func notFound(resource string) (int, any) {
+	return errorResponse(http.StatusNotFound, "not_found", resource+" not found")
+}
+
+func writeError(w http.ResponseWriter, status int, name, message string) {
+	writeJSON(w, status, &apiError{StatusCode: status, Name: name, Message: message})
+}
+
+// respond returns a function writing a status and document pair, ie:
+// respond(w)(notFound("Email"))
+func respond(w http.ResponseWriter) func(status int, doc any) {
+	return func(status int, doc any) {
+		writeJSON(w, status, doc)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// decode decodes a JSON document, returning the response of an invalid body
+func decode(body []byte, v any) (int, any, bool) {
+	if err := json.Unmarshal(body, v); err != nil {
+		status, doc := validationError("Invalid JSON body: " + err.Error())
+		return status, doc, false
+	}
+	return 0, nil, true
+}
+
+// list is the document of a paginated list
+type list struct {
+	Object  string `json:"object"`
+	HasMore bool   `json:"has_more"`
+	Data    any    `json:"data"`
+}
+
+// paginate returns the page of ids selected by the limit, after and before
+// query parameters. ids are ordered from newest to oldest.
+func paginate(ids []string, query url.Values) (page []string, hasMore bool, status int, doc any) {
+	limit := 20
+	if v := query.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > 100 {
+			status, doc = validationError("The `limit` parameter must be between 1 and 100.")
+			return nil, false, status, doc
+		}
+		limit = n
+	}
+
+	after, before := query.Get("after"), query.Get("before")
+	if after != "" && before != "" {
+		status, doc = validationError("You can only use either `after` or `before`, not both.")
+		return nil, false, status, doc
+	}
+
+	switch {
+	case after != "":
+		i := slices.Index(ids, after)
+		if i < 0 {
+			status, doc = validationError("The `after` cursor is invalid.")
+			return nil, false, status, doc
+		}
+		rest := ids[i+1:]
+		page = rest[:min(limit, len(rest))]
+		return page, len(rest) > limit, 0, nil
+	case before != "":
+		i := slices.Index(ids, before)
+		if i < 0 {
+			status, doc = validationError("The `before` cursor is invalid.")
+			return nil, false, status, doc
+		}
+		start := max(i-limit, 0)
+		return ids[start:i], start > 0, 0, nil
+	default:
+		page = ids[:min(limit, len(ids))]
+		return page, len(ids) > limit, 0, nil
+	}
+}
+
+// newestFirst returns a copy of ids, ordered by creation, in reverse order
+func newestFirst(ids []string) []string {
+	ids = slices.Clone(ids)
+	slices.Reverse(ids)
+	return ids
+}
+
+// formatTime formats a timestamp the way the API does
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeFormat)
+}
@@ -0,0 +1,67 @@
+package resendtest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/resend/resend-go/v3"
+	"github.com/resend/resend-go/v3/resendtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInjectFault(t *testing.T) {
+	srv := resendtest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	srv.InjectFault(resendtest.Fault{Path: "/emails", StatusCode: http.StatusTooManyRequests, Times: 1})
+	_, err := client.Emails.Send(testEmail("jane@example.com"))
+	assert.True(t, errors.Is(err, resend.ErrRateLimit))
+	var rateLimitErr *resend.RateLimitError
+	assert.True(t, errors.As(err, &rateLimitErr))
+	assert.Equal(t, "0", rateLimitErr.Remaining)
+
+	// The fault is exhausted
+	_, err = client.Emails.Send(testEmail("jane@example.com"))
+	assert.NoError(t, err)
+
+	srv.InjectFault(resendtest.Fault{Method: http.MethodGet, StatusCode: http.StatusInternalServerError})
+	_, err = client.Emails.Get("any")
+	assert.True(t, errors.Is(err, resend.ErrServer))
+	_, err = client.Emails.Get("any")
+	assert.True(t, errors.Is(err, resend.ErrServer))
+
+	srv.ClearFaults()
+	_, err = client.Emails.Get("any")
+	assert.True(t, errors.Is(err, resend.ErrNotFound))
+}
+
+func TestInjectFaultWithRetries(t *testing.T) {
+	srv := resendtest.NewServer()
+	defer srv.Close()
+	client := srv.Client(resend.WithRetryPolicy(&resend.RetryPolicy{
+		MaxAttempts:          3,
+		BaseBackoff:          time.Millisecond,
+		MaxBackoff:           5 * time.Millisecond,
+		RetryableStatusCodes: []int{http.StatusTooManyRequests, http.StatusInternalServerError},
+	}))
+
+	srv.InjectFault(resendtest.Fault{Path: "/emails", StatusCode: http.StatusInternalServerError, Times: 2})
+	sent, err := client.Emails.SendWithOptions(context.Background(), testEmail("jane@example.com"),
+		&resend.SendEmailOptions{IdempotencyKey: "retry"})
+	assert.NoError(t, err)
+	assert.Len(t, srv.Messages(), 1)
+	assert.Equal(t, sent.Id, srv.Messages()[0].Id)
+}
+
+func TestMissingAPIKey(t *testing.T) {
+	srv := resendtest.NewServer()
+	defer srv.Close()
+
+	client := resend.NewClient("", resend.WithBaseURL(srv.URL))
+	_, err := client.Emails.Get("any")
+	assert.True(t, errors.Is(err, resend.ErrUnauthorized))
+}
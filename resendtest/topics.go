@@ -0,0 +1,146 @@
+package resendtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/resend/resend-go/v3"
+)
+
+// topic is a stored topic
+type topic struct {
+	id                  string
+	name                string
+	description         string
+	defaultSubscription resend.DefaultSubscription
+	createdAt           time.Time
+}
+
+func (t *topic) document() *resend.Topic {
+	return &resend.Topic{
+		Id:                  t.id,
+		Name:                t.name,
+		Description:         t.description,
+		DefaultSubscription: t.defaultSubscription,
+		CreatedAt:           formatTime(t.createdAt),
+	}
+}
+
+func (s *Server) registerTopics() {
+	s.mux.HandleFunc("POST /topics", s.createTopic)
+	s.mux.HandleFunc("GET /topics", s.listTopics)
+	s.mux.HandleFunc("GET /topics/{id}", s.getTopic)
+	s.mux.HandleFunc("PATCH /topics/{id}", s.updateTopic)
+	s.mux.HandleFunc("DELETE /topics/{id}", s.removeTopic)
+}
+
+func (s *Server) createTopic(w http.ResponseWriter, r *http.Request) {
+	var req resend.CreateTopicRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "validation_error", "Invalid JSON body: "+err.Error())
+		return
+	}
+	switch {
+	case strings.TrimSpace(req.Name) == "":
+		writeError(w, http.StatusUnprocessableEntity, "missing_required_field", "Missing `name` field.")
+		return
+	case req.DefaultSubscription == "":
+		writeError(w, http.StatusUnprocessableEntity, "missing_required_field", "Missing `default_subscription` field.")
+		return
+	case !req.DefaultSubscription.IsValid():
+		writeError(w, http.StatusUnprocessableEntity, "validation_error", "The `default_subscription` field must be `opt_in` or `opt_out`.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := &topic{
+		id:                  s.newId(),
+		name:                req.Name,
+		description:         req.Description,
+		defaultSubscription: req.DefaultSubscription,
+		createdAt:           s.now(),
+	}
+	s.topics[t.id] = t
+	s.topicsOrder = append(s.topicsOrder, t.id)
+	writeJSON(w, http.StatusCreated, &resend.CreateTopicResponse{Id: t.id})
+}
+
+func (s *Server) listTopics(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	page, hasMore, status, doc := paginate(newestFirst(s.topicsOrder), r.URL.Query())
+	if status != 0 {
+		writeJSON(w, status, doc)
+		return
+	}
+
+	data := make([]*resend.Topic, len(page))
+	for i, id := range page {
+		data[i] = s.topics[id].document()
+	}
+	writeJSON(w, http.StatusOK, &list{Object: "list", HasMore: hasMore, Data: data})
+}
+
+func (s *Server) getTopic(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.topics[r.PathValue("id")]
+	if !ok {
+		respond(w)(notFound("Topic"))
+		return
+	}
+	writeJSON(w, http.StatusOK, t.document())
+}
+
+func (s *Server) updateTopic(w http.ResponseWriter, r *http.Request) {
+	var req map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "validation_error", "Invalid JSON body: "+err.Error())
+		return
+	}
+	if _, ok := req["default_subscription"]; ok {
+		writeError(w, http.StatusUnprocessableEntity, "validation_error", "The `default_subscription` field cannot be changed.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.topics[r.PathValue("id")]
+	if !ok {
+		respond(w)(notFound("Topic"))
+		return
+	}
+	if name, ok := req["name"].(string); ok && name != "" {
+		t.name = name
+	}
+	if description, ok := req["description"].(string); ok {
+		t.description = description
+	}
+	writeJSON(w, http.StatusOK, &resend.UpdateTopicResponse{Id: t.id})
+}
+
+func (s *Server) removeTopic(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := s.topics[id]; !ok {
+		respond(w)(notFound("Topic"))
+		return
+	}
+
+	delete(s.topics, id)
+	s.topicsOrder = slices.DeleteFunc(s.topicsOrder, func(v string) bool { return v == id })
+	for _, c := range s.contacts {
+		delete(c.topics, id)
+	}
+	writeJSON(w, http.StatusOK, &resend.RemoveTopicResponse{Id: id, Object: "topic", Deleted: true})
+}
@@ -0,0 +1,106 @@
+package resend
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ResponseMeta holds the metadata of the HTTP response to a request.
+//
+// Pass a ResponseMeta to WithResponseMeta and use the returned context with
+// any ...WithContext or ...WithOptions method to capture it:
+//
+//	var meta resend.ResponseMeta
+//	ctx := resend.WithResponseMeta(context.Background(), &meta)
+//	sent, err := client.Emails.SendWithContext(ctx, params)
+//	log.Println(meta.RequestId, meta.RateLimit.Remaining)
+//
+// It is filled for successful and failed requests alike. When a request is
+// retried it describes the last attempt.
+type ResponseMeta struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+
+	// Header is the full set of response headers
+	Header http.Header
+
+	// RequestId is the value of the x-request-id header
+	RequestId string
+
+	// RateLimit holds the parsed rate limit headers
+	RateLimit RateLimitInfo
+
+	// Attempts is the number of attempts made for the request, including retries
+	Attempts int
+}
+
+// RateLimitInfo holds the parsed values of the rate limit headers.
+// Fields are zero when the corresponding header is missing or invalid.
+type RateLimitInfo struct {
+	// Limit is the maximum number of requests allowed in the current window
+	Limit int
+
+	// Remaining is the number of requests remaining in the current window
+	Remaining int
+
+	// Reset is the time until the current window resets
+	Reset time.Duration
+
+	// RetryAfter is the recommended wait time before retrying, only set on 429 responses
+	RetryAfter time.Duration
+
+	// Present reports whether the response had rate limit headers
+	Present bool
+}
+
+// responseMetaKey is the context key of the ResponseMeta collector
+type responseMetaKey struct{}
+
+// WithResponseMeta returns a context that fills meta with the metadata of
+// the response to every request made with it. The same ResponseMeta must not
+// be shared by concurrent requests.
+func WithResponseMeta(ctx context.Context, meta *ResponseMeta) context.Context {
+	return context.WithValue(ctx, responseMetaKey{}, meta)
+}
+
+// collectResponseMeta fills the context's ResponseMeta collector, if any,
+// with the metadata of resp.
+func collectResponseMeta(ctx context.Context, resp *http.Response) {
+	meta, ok := ctx.Value(responseMetaKey{}).(*ResponseMeta)
+	if !ok || meta == nil {
+		return
+	}
+
+	*meta = ResponseMeta{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		RequestId:  resp.Header.Get(requestIdHeader),
+		RateLimit:  parseRateLimitInfo(resp.Header),
+		Attempts:   RetryAttempt(ctx),
+	}
+}
+
+// parseRateLimitInfo parses the ratelimit-* and retry-after headers
+func parseRateLimitInfo(header http.Header) RateLimitInfo {
+	var info RateLimitInfo
+
+	if v, err := strconv.Atoi(header.Get("ratelimit-limit")); err == nil {
+		info.Limit = v
+		info.Present = true
+	}
+	if v, err := strconv.Atoi(header.Get("ratelimit-remaining")); err == nil {
+		info.Remaining = v
+		info.Present = true
+	}
+	if v, err := strconv.Atoi(header.Get("ratelimit-reset")); err == nil {
+		info.Reset = time.Duration(v) * time.Second
+		info.Present = true
+	}
+	if header.Get("retry-after") != "" {
+		info.RetryAfter, _ = retryAfter(http.Header{"Retry-After": header.Values("retry-after")})
+	}
+
+	return info
+}
@@ -0,0 +1,79 @@
+package resend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithResponseMeta(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/emails", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req_123")
+		w.Header().Set("ratelimit-limit", "10")
+		w.Header().Set("ratelimit-remaining", "9")
+		w.Header().Set("ratelimit-reset", "1")
+		json.NewEncoder(w).Encode(&SendEmailResponse{Id: "1"})
+	})
+
+	var meta ResponseMeta
+	ctx := WithResponseMeta(context.Background(), &meta)
+	_, err := client.Emails.SendWithContext(ctx, &SendEmailRequest{
+		From:    "onboarding@resend.dev",
+		To:      []string{"delivered@resend.dev"},
+		Subject: "Hello",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, meta.StatusCode)
+	assert.Equal(t, "req_123", meta.RequestId)
+	assert.Equal(t, "application/json", meta.Header.Get("Content-Type"))
+	assert.Equal(t, 1, meta.Attempts)
+	assert.Equal(t, RateLimitInfo{Limit: 10, Remaining: 9, Reset: time.Second, Present: true}, meta.RateLimit)
+}
+
+func TestWithResponseMetaOnError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/emails/123", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("X-Request-Id", "req_"+string(rune('0'+attempts)))
+		w.Header().Set("ratelimit-limit", "2")
+		w.Header().Set("ratelimit-remaining", "0")
+		w.Header().Set("retry-after", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	client.RetryPolicy = testRetryPolicy()
+
+	var meta ResponseMeta
+	ctx := WithResponseMeta(context.Background(), &meta)
+	_, err := client.Emails.GetWithContext(ctx, "123")
+	assert.True(t, errors.Is(err, ErrRateLimit))
+
+	assert.Equal(t, http.StatusTooManyRequests, meta.StatusCode)
+	assert.Equal(t, "req_3", meta.RequestId)
+	assert.Equal(t, 3, meta.Attempts)
+	assert.Equal(t, 2, meta.RateLimit.Limit)
+	assert.Equal(t, 0, meta.RateLimit.Remaining)
+	assert.True(t, meta.RateLimit.Present)
+}
+
+func TestParseRateLimitInfo(t *testing.T) {
+	assert.Equal(t, RateLimitInfo{}, parseRateLimitInfo(http.Header{}))
+
+	header := http.Header{}
+	header.Set("ratelimit-remaining", "0")
+	header.Set("retry-after", "3")
+	assert.Equal(t, RateLimitInfo{RetryAfter: 3 * time.Second, Present: true}, parseRateLimitInfo(header))
+}
@@ -0,0 +1,262 @@
+package resend
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy configures how the Client retries failed requests.
+//
+// A nil policy (the default) disables retries and every request is attempted
+// exactly once.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values lower than 2 disable retries.
+	MaxAttempts int
+
+	// BaseBackoff is the delay before the first retry. It doubles on each
+	// subsequent attempt.
+	BaseBackoff time.Duration
+
+	// MaxBackoff caps the computed exponential delay. Delays requested by the
+	// API through the retry-after or ratelimit-reset headers are not capped.
+	MaxBackoff time.Duration
+
+	// Jitter is the fraction (0 to 1) of the computed delay that is randomized
+	// to avoid synchronized retries from concurrent callers.
+	Jitter float64
+
+	// RetryableStatusCodes lists the HTTP status codes that trigger a retry.
+	RetryableStatusCodes []int
+
+	// RetryNetworkErrors enables retries for transport errors such as
+	// connection resets, refused connections and timeouts.
+	RetryNetworkErrors bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy with sensible defaults:
+// 3 attempts, exponential backoff starting at 500ms capped at 10s,
+// 20% jitter, and retries on 429, 5xx gateway errors and network errors.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		BaseBackoff: 500 * time.Millisecond,
+		MaxBackoff:  10 * time.Second,
+		Jitter:      0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryNetworkErrors: true,
+	}
+}
+
+// canRetry reports whether another attempt is allowed for the given request
+// after the given number of attempts.
+func (p *RetryPolicy) canRetry(req *http.Request, attempt int) bool {
+	if p == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+
+	// The body must be replayable between attempts
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	return isIdempotent(req)
+}
+
+// isRetryableStatus reports whether the status code is configured as retryable.
+func (p *RetryPolicy) isRetryableStatus(code int) bool {
+	for _, c := range p.RetryableStatusCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// isRetryableError reports whether a transport error is worth retrying.
+func (p *RetryPolicy) isRetryableError(err error) bool {
+	if !p.RetryNetworkErrors {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr)
+}
+
+// backoff returns the delay to wait before the next attempt. The retry-after
+// and ratelimit-reset response headers take precedence over the computed
+// exponential delay.
+func (p *RetryPolicy) backoff(attempt int, header http.Header) time.Duration {
+	if d, ok := retryAfter(header); ok {
+		return d
+	}
+
+	delay := float64(p.BaseBackoff) * math.Pow(2, float64(attempt-1))
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+
+	jitter := math.Min(math.Max(p.Jitter, 0), 1)
+	delay -= delay * jitter * rand.Float64()
+
+	return time.Duration(delay)
+}
+
+// validate checks that the policy values are within range.
+func (p *RetryPolicy) validate() error {
+	if p.MaxAttempts < 0 {
+		return errors.New("[ERROR]: RetryPolicy.MaxAttempts must not be negative")
+	}
+	if p.BaseBackoff < 0 || p.MaxBackoff < 0 {
+		return errors.New("[ERROR]: RetryPolicy backoff durations must not be negative")
+	}
+	if p.MaxBackoff > 0 && p.MaxBackoff < p.BaseBackoff {
+		return errors.New("[ERROR]: RetryPolicy.MaxBackoff must not be lower than BaseBackoff")
+	}
+	if p.Jitter < 0 || p.Jitter > 1 {
+		return errors.New("[ERROR]: RetryPolicy.Jitter must be between 0 and 1")
+	}
+	return nil
+}
+
+// retryAttemptKey is the context key holding the current attempt number
+type retryAttemptKey struct{}
+
+// RetryAttempt returns the attempt number (starting at 1) of the request
+// carrying the given context, as set by RetryMiddleware.
+func RetryAttempt(ctx context.Context) int {
+	if attempt, ok := ctx.Value(retryAttemptKey{}).(int); ok {
+		return attempt
+	}
+	return 1
+}
+
+// RetryMiddleware returns a Middleware retrying failed requests according
+// to the given policy. A nil policy disables retries.
+func RetryMiddleware(policy *RetryPolicy) Middleware {
+	return func(next Handler) Handler {
+		if policy == nil {
+			return next
+		}
+		return func(req *http.Request, ret any) (*http.Response, error) {
+			ctx := req.Context()
+			for attempt := 1; ; attempt++ {
+				if attempt > 1 {
+					if err := rewindBody(req); err != nil {
+						return nil, err
+					}
+				}
+
+				resp, err := next(req.WithContext(context.WithValue(ctx, retryAttemptKey{}, attempt)), ret)
+				if err == nil || !policy.canRetry(req, attempt) || !policy.shouldRetry(err) {
+					return resp, err
+				}
+
+				if !sleepContext(ctx, policy.backoff(attempt, errorHeader(err))) {
+					return resp, err
+				}
+			}
+		}
+	}
+}
+
+// shouldRetry reports whether the error returned by an attempt is retryable.
+func (p *RetryPolicy) shouldRetry(err error) bool {
+	if status := errorStatusCode(err); status != 0 {
+		return p.isRetryableStatus(status)
+	}
+	return p.isRetryableError(err)
+}
+
+// isIdempotent reports whether a request can be safely sent more than once.
+// Non-idempotent methods are only retried when an Idempotency-Key is set.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return req.Header.Get("Idempotency-Key") != ""
+}
+
+// retryAfter parses the delay requested by the API, either from the
+// retry-after header (seconds or HTTP date) or the ratelimit-reset header (seconds).
+func retryAfter(header http.Header) (time.Duration, bool) {
+	if header == nil {
+		return 0, false
+	}
+
+	if v := header.Get("retry-after"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+			return time.Duration(secs) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return max(time.Until(t), 0), true
+		}
+	}
+
+	if v := header.Get("ratelimit-reset"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+			return time.Duration(secs) * time.Second, true
+		}
+	}
+
+	return 0, false
+}
+
+// sleepContext waits for the given duration, returning false if the context
+// is done first or its deadline would expire before the wait is over.
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+		return false
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// rewindBody resets the request body so the request can be sent again.
+func rewindBody(req *http.Request) error {
+	if req.GetBody == nil || req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+	return nil
+}
@@ -0,0 +1,201 @@
+package resend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testRetryPolicy() *RetryPolicy {
+	p := DefaultRetryPolicy()
+	p.BaseBackoff = time.Millisecond
+	p.MaxBackoff = 5 * time.Millisecond
+	return p
+}
+
+func TestPerformRetriesRetryableStatus(t *testing.T) {
+	setup()
+	defer teardown()
+	client.RetryPolicy = testRetryPolicy()
+
+	attempts := 0
+	mux.HandleFunc("/emails/123", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Content-Type", "application/json")
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"message":"unavailable"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(&Email{Id: "123"})
+	})
+
+	email, err := client.Emails.Get("123")
+	assert.NoError(t, err)
+	assert.Equal(t, "123", email.Id)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestPerformStopsAfterMaxAttempts(t *testing.T) {
+	setup()
+	defer teardown()
+	client.RetryPolicy = testRetryPolicy()
+
+	attempts := 0
+	mux.HandleFunc("/emails/123", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"message":"Server error"}`))
+	})
+
+	_, err := client.Emails.Get("123")
+	assert.EqualError(t, err, "[ERROR]: Server error")
+	assert.Equal(t, 3, attempts)
+}
+
+func TestPerformDoesNotRetryWithoutPolicy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/emails/123", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := client.Emails.Get("123")
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestPerformDoesNotRetryPostWithoutIdempotencyKey(t *testing.T) {
+	setup()
+	defer teardown()
+	client.RetryPolicy = testRetryPolicy()
+
+	attempts := 0
+	mux.HandleFunc("/emails", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := client.Emails.Send(&SendEmailRequest{To: []string{"d@e.com"}})
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestPerformRetriesPostWithIdempotencyKeyAndReplaysBody(t *testing.T) {
+	setup()
+	defer teardown()
+	client.RetryPolicy = testRetryPolicy()
+
+	var bodies []string
+	mux.HandleFunc("/emails", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		assert.Equal(t, "key-1", r.Header.Get("Idempotency-Key"))
+
+		w.Header().Set("Content-Type", "application/json")
+		if len(bodies) == 1 {
+			w.Header().Set("retry-after", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"message":"Too many requests"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(&SendEmailResponse{Id: "1"})
+	})
+
+	resp, err := client.Emails.SendWithOptions(context.Background(),
+		&SendEmailRequest{To: []string{"d@e.com"}, Subject: "hi"},
+		&SendEmailOptions{IdempotencyKey: "key-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", resp.Id)
+	assert.Len(t, bodies, 2)
+	assert.Equal(t, bodies[0], bodies[1])
+	assert.Contains(t, bodies[1], `"subject":"hi"`)
+}
+
+func TestPerformRetryRespectsContextDeadline(t *testing.T) {
+	setup()
+	defer teardown()
+	client.RetryPolicy = testRetryPolicy()
+
+	attempts := 0
+	mux.HandleFunc("/emails/123", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("retry-after", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.Emails.GetWithContext(ctx, "123")
+	assert.True(t, errors.Is(err, ErrRateLimit))
+	assert.Equal(t, 1, attempts)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestPerformRetriesNetworkErrors(t *testing.T) {
+	setup()
+	defer teardown()
+	client.RetryPolicy = testRetryPolicy()
+
+	attempts := 0
+	mux.HandleFunc("/emails/123", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err == nil {
+				conn.Close()
+			}
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&Email{Id: "123"})
+	})
+
+	email, err := client.Emails.Get("123")
+	assert.NoError(t, err)
+	assert.Equal(t, "123", email.Id)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestRetryAfter(t *testing.T) {
+	d, ok := retryAfter(http.Header{"Retry-After": {"3"}})
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, d)
+
+	d, ok = retryAfter(http.Header{"Ratelimit-Reset": {"2"}})
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Second, d)
+
+	d, ok = retryAfter(http.Header{"Retry-After": {time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)}})
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), d)
+
+	_, ok = retryAfter(http.Header{"Retry-After": {"soon"}})
+	assert.False(t, ok)
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := &RetryPolicy{BaseBackoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond}
+	assert.Equal(t, 100*time.Millisecond, p.backoff(1, nil))
+	assert.Equal(t, 200*time.Millisecond, p.backoff(2, nil))
+	assert.Equal(t, 300*time.Millisecond, p.backoff(3, nil))
+
+	p.Jitter = 0.5
+	for i := 0; i < 20; i++ {
+		d := p.backoff(1, nil)
+		assert.GreaterOrEqual(t, d, 50*time.Millisecond)
+		assert.LessOrEqual(t, d, 100*time.Millisecond)
+	}
+}
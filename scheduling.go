@@ -0,0 +1,130 @@
+package resend
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MaxScheduleHorizon is how far in the future emails and broadcasts can be
+// scheduled
+const MaxScheduleHorizon = 30 * 24 * time.Hour
+
+// timeNow is replaced in tests
+var timeNow = time.Now
+
+// formatScheduledAt formats a schedule time as ISO 8601 in UTC
+func formatScheduledAt(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// checkScheduledAt returns the problem with a schedule time, if any
+func checkScheduledAt(t time.Time) string {
+	switch {
+	case t.IsZero():
+		return "schedule time is required"
+	case !t.After(timeNow()):
+		return "must be in the future"
+	case t.After(timeNow().Add(MaxScheduleHorizon)):
+		return fmt.Sprintf("must be within %d days", MaxScheduleHorizon/(24*time.Hour))
+	}
+	return ""
+}
+
+// checkScheduledAtString returns the problem with a ScheduledAt field. Only
+// ISO 8601 values are checked, natural language is left to the API.
+func checkScheduledAtString(s string) string {
+	if s == "" || strings.HasPrefix(strings.TrimSpace(s), "in ") {
+		return ""
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return ""
+	}
+	if t.After(timeNow().Add(MaxScheduleHorizon)) {
+		return fmt.Sprintf("must be within %d days", MaxScheduleHorizon/(24*time.Hour))
+	}
+	return ""
+}
+
+// schedule returns the ScheduledAt value of t, or a *ValidationError
+func schedule(t time.Time) (string, error) {
+	if msg := checkScheduledAt(t); msg != "" {
+		return "", &ValidationError{Fields: []FieldError{{Field: "scheduled_at", Message: msg}}}
+	}
+	return formatScheduledAt(t), nil
+}
+
+// ScheduleAt schedules the email to be sent at t. It returns a
+// *ValidationError if t is not in the future or beyond MaxScheduleHorizon.
+func (r *SendEmailRequest) ScheduleAt(t time.Time) error {
+	v, err := schedule(t)
+	if err != nil {
+		return err
+	}
+	r.ScheduledAt = v
+	return nil
+}
+
+// ScheduleIn schedules the email to be sent once d has elapsed, from now.
+// It returns a *ValidationError if d is not positive or beyond
+// MaxScheduleHorizon.
+func (r *SendEmailRequest) ScheduleIn(d time.Duration) error {
+	return r.ScheduleAt(timeNow().Add(d))
+}
+
+// ScheduleAt reschedules the email to be sent at t. It returns a
+// *ValidationError if t is not in the future or beyond MaxScheduleHorizon.
+func (r *UpdateEmailRequest) ScheduleAt(t time.Time) error {
+	v, err := schedule(t)
+	if err != nil {
+		return err
+	}
+	r.ScheduledAt = v
+	return nil
+}
+
+// ScheduleIn reschedules the email to be sent once d has elapsed, from now.
+// It returns a *ValidationError if d is not positive or beyond
+// MaxScheduleHorizon.
+func (r *UpdateEmailRequest) ScheduleIn(d time.Duration) error {
+	return r.ScheduleAt(timeNow().Add(d))
+}
+
+// ScheduleAt schedules the broadcast to be sent at t. It returns a
+// *ValidationError if t is not in the future or beyond MaxScheduleHorizon.
+func (r *SendBroadcastRequest) ScheduleAt(t time.Time) error {
+	v, err := schedule(t)
+	if err != nil {
+		return err
+	}
+	r.ScheduledAt = v
+	return nil
+}
+
+// ScheduleIn schedules the broadcast to be sent once d has elapsed, from
+// now. It returns a *ValidationError if d is not positive or beyond
+// MaxScheduleHorizon.
+func (r *SendBroadcastRequest) ScheduleIn(d time.Duration) error {
+	return r.ScheduleAt(timeNow().Add(d))
+}
+
+// ScheduleAt schedules the broadcast to be sent at t, setting Send. It
+// returns a *ValidationError if t is not in the future or beyond
+// MaxScheduleHorizon.
+func (r *CreateBroadcastRequest) ScheduleAt(t time.Time) error {
+	v, err := schedule(t)
+	if err != nil {
+		return err
+	}
+	r.ScheduledAt = v
+	r.Send = true
+	return nil
+}
+
+// ScheduleIn schedules the broadcast to be sent once d has elapsed, from
+// now, setting Send. It returns a *ValidationError if d is not positive or
+// beyond MaxScheduleHorizon.
+func (r *CreateBroadcastRequest) ScheduleIn(d time.Duration) error {
+	return r.ScheduleAt(timeNow().Add(d))
+}
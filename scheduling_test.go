@@ -0,0 +1,86 @@
+package resend
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func fixClock(t *testing.T, at time.Time) {
+	timeNow = func() time.Time { return at }
+	t.Cleanup(func() { timeNow = time.Now })
+}
+
+func TestScheduleAt(t *testing.T) {
+	fixClock(t, time.Date(2024, 8, 5, 11, 0, 0, 0, time.UTC))
+	paris := time.FixedZone("CEST", 2*3600)
+
+	r := &SendEmailRequest{}
+	assert.NoError(t, r.ScheduleAt(time.Date(2024, 8, 5, 15, 30, 0, 0, paris)))
+	assert.Equal(t, "2024-08-05T13:30:00Z", r.ScheduledAt)
+
+	assert.NoError(t, r.ScheduleIn(90*time.Minute))
+	assert.Equal(t, "2024-08-05T12:30:00Z", r.ScheduledAt)
+
+	for _, d := range []time.Duration{0, -time.Minute, MaxScheduleHorizon + time.Second} {
+		err := r.ScheduleIn(d)
+		var validationErr *ValidationError
+		assert.True(t, errors.As(err, &validationErr), d.String())
+		assert.Equal(t, "scheduled_at", validationErr.Fields[0].Field)
+	}
+	assert.Error(t, r.ScheduleAt(time.Time{}))
+	assert.Equal(t, "2024-08-05T12:30:00Z", r.ScheduledAt)
+
+	update := &UpdateEmailRequest{Id: "1"}
+	assert.NoError(t, update.ScheduleIn(MaxScheduleHorizon))
+	assert.Equal(t, "2024-09-04T11:00:00Z", update.ScheduledAt)
+
+	send := &SendBroadcastRequest{BroadcastId: "1"}
+	assert.NoError(t, send.ScheduleIn(time.Hour))
+	assert.Equal(t, "2024-08-05T12:00:00Z", send.ScheduledAt)
+
+	create := &CreateBroadcastRequest{}
+	assert.NoError(t, create.ScheduleAt(time.Date(2024, 8, 6, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "2024-08-06T00:00:00Z", create.ScheduledAt)
+	assert.True(t, create.Send)
+}
+
+func TestValidateScheduledAt(t *testing.T) {
+	fixClock(t, time.Date(2024, 8, 5, 11, 0, 0, 0, time.UTC))
+
+	r := validEmail()
+	r.ScheduledAt = "in 1 hour"
+	assert.NoError(t, r.Validate())
+
+	r.ScheduledAt = "2024-08-10T11:00:00Z"
+	assert.NoError(t, r.Validate())
+
+	r.ScheduledAt = "2024-10-10T11:00:00Z"
+	assert.Equal(t, []string{"scheduled_at"}, fields(r.Validate()))
+}
+
+func TestGetEmailScheduledTime(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/emails/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"id":           "1",
+			"object":       "email",
+			"last_event":   "scheduled",
+			"scheduled_at": "2024-08-05 11:52:01.858+00",
+		})
+	})
+
+	email, err := client.Emails.Get("1")
+	assert.NoError(t, err)
+	scheduled, err := email.ScheduledTime()
+	assert.NoError(t, err)
+	assert.True(t, time.Date(2024, 8, 5, 11, 52, 1, 858000000, time.UTC).Equal(scheduled.Time))
+}
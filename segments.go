@@ -3,6 +3,7 @@ package resend
 import (
 	"context"
 	"errors"
+	"iter"
 	"net/http"
 )
 
@@ -12,6 +13,7 @@ type SegmentsSvc interface {
 	ListWithOptions(ctx context.Context, options *ListOptions) (ListSegmentsResponse, error)
 	ListWithContext(ctx context.Context) (ListSegmentsResponse, error)
 	List() (ListSegmentsResponse, error)
+	All(ctx context.Context, options *ListOptions) iter.Seq2[Segment, error]
 	GetWithContext(ctx context.Context, segmentId string) (Segment, error)
 	Get(segmentId string) (Segment, error)
 	RemoveWithContext(ctx context.Context, segmentId string) (RemoveSegmentResponse, error)
@@ -30,18 +32,24 @@ type CreateSegmentResponse struct {
 	Id     string `json:"id"`
 	Name   string `json:"name"`
 	Object string `json:"object"`
+
+	unknownFields
 }
 
 type RemoveSegmentResponse struct {
 	Id      string `json:"id"`
 	Object  string `json:"object"`
 	Deleted bool   `json:"deleted"`
+
+	unknownFields
 }
 
 type ListSegmentsResponse struct {
 	Object  string    `json:"object"`
 	Data    []Segment `json:"data"`
 	HasMore bool      `json:"has_more"`
+
+	unknownFields
 }
 
 type Segment struct {
@@ -49,6 +57,8 @@ type Segment struct {
 	Name      string `json:"name"`
 	Object    string `json:"object"`
 	CreatedAt string `json:"created_at"`
+
+	unknownFields
 }
 
 // CreateWithContext creates a new Segment entry based on the given params
@@ -116,6 +126,16 @@ func (s *SegmentsSvcImpl) List() (ListSegmentsResponse, error) {
 	return s.ListWithContext(context.Background())
 }
 
+// All iterates over all segments, fetching pages lazily
+// https://resend.com/docs/api-reference/segments/list-segments
+func (s *SegmentsSvcImpl) All(ctx context.Context, options *ListOptions) iter.Seq2[Segment, error] {
+	return paginate(ctx, listCursor(options), func(item Segment) string { return item.Id },
+		func(ctx context.Context, cursor ListOptions) ([]Segment, bool, error) {
+			resp, err := s.ListWithOptions(ctx, &cursor)
+			return resp.Data, resp.HasMore, err
+		})
+}
+
 // RemoveWithContext removes a given segment by id
 // https://resend.com/docs/api-reference/segments/delete-segment
 func (s *SegmentsSvcImpl) RemoveWithContext(ctx context.Context, segmentId string) (RemoveSegmentResponse, error) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 )
@@ -26,6 +27,8 @@ type SuppressionListEntry struct {
 	// bounced or complained. It is null for manual suppressions.
 	SourceId  *string `json:"source_id"`
 	CreatedAt string  `json:"created_at"`
+
+	unknownFields
 }
 
 // Suppression is a suppressed email address as returned by Get.
@@ -38,6 +41,8 @@ type Suppression struct {
 	// bounced or complained. It is null for manual suppressions.
 	SourceId  *string `json:"source_id"`
 	CreatedAt string  `json:"created_at"`
+
+	unknownFields
 }
 
 // AddSuppressionRequest contains params for suppressing an email address.
@@ -48,6 +53,8 @@ type AddSuppressionRequest struct {
 type AddSuppressionResponse struct {
 	Object string `json:"object"`
 	Id     string `json:"id"`
+
+	unknownFields
 }
 
 // ListSuppressionsOptions contains parameters for listing suppressions.
@@ -63,12 +70,16 @@ type ListSuppressionsResponse struct {
 	Object  string                 `json:"object"`
 	HasMore bool                   `json:"has_more"`
 	Data    []SuppressionListEntry `json:"data"`
+
+	unknownFields
 }
 
 type RemoveSuppressionResponse struct {
 	Object  string `json:"object"`
 	Id      string `json:"id"`
 	Deleted bool   `json:"deleted"`
+
+	unknownFields
 }
 
 // BatchAddSuppressionsRequest contains params for suppressing up to 100 email addresses at once.
@@ -78,6 +89,8 @@ type BatchAddSuppressionsRequest struct {
 
 type BatchAddSuppressionsResponse struct {
 	Data []AddSuppressionResponse `json:"data"`
+
+	unknownFields
 }
 
 // BatchRemoveSuppressionsRequest contains params for removing up to 100 suppressions at once.
@@ -90,6 +103,8 @@ type BatchRemoveSuppressionsRequest struct {
 
 type BatchRemoveSuppressionsResponse struct {
 	Data []RemoveSuppressionResponse `json:"data"`
+
+	unknownFields
 }
 
 type SuppressionsSvc interface {
@@ -97,6 +112,7 @@ type SuppressionsSvc interface {
 	AddWithContext(ctx context.Context, params *AddSuppressionRequest) (AddSuppressionResponse, error)
 	List(options *ListSuppressionsOptions) (ListSuppressionsResponse, error)
 	ListWithContext(ctx context.Context, options *ListSuppressionsOptions) (ListSuppressionsResponse, error)
+	All(ctx context.Context, options *ListSuppressionsOptions) iter.Seq2[SuppressionListEntry, error]
 	Get(idOrEmail string) (Suppression, error)
 	GetWithContext(ctx context.Context, idOrEmail string) (Suppression, error)
 	Remove(idOrEmail string) (RemoveSuppressionResponse, error)
@@ -182,6 +198,24 @@ func (s *SuppressionsSvcImpl) ListWithContext(ctx context.Context, options *List
 	return *resp, nil
 }
 
+// All iterates over all suppressions, fetching pages lazily.
+// The Limit, After and Before options are used for the first page.
+// https://resend.com/docs/api-reference/suppressions/list-suppressions
+func (s *SuppressionsSvcImpl) All(ctx context.Context, options *ListSuppressionsOptions) iter.Seq2[SuppressionListEntry, error] {
+	var opts ListSuppressionsOptions
+	if options != nil {
+		opts = *options
+	}
+	cursor := ListOptions{Limit: opts.Limit, After: opts.After, Before: opts.Before}
+
+	return paginate(ctx, cursor, func(e SuppressionListEntry) string { return e.Id },
+		func(ctx context.Context, cursor ListOptions) ([]SuppressionListEntry, bool, error) {
+			opts.Limit, opts.After, opts.Before = cursor.Limit, cursor.After, cursor.Before
+			resp, err := s.ListWithContext(ctx, &opts)
+			return resp.Data, resp.HasMore, err
+		})
+}
+
 // Get retrieves a single suppression. The idOrEmail param can be either a suppression ID or an
 // email address. An unknown identifier returns a 404 "Suppression not found" error.
 // https://resend.com/docs/api-reference/suppressions/get-suppression
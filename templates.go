@@ -2,6 +2,7 @@ package resend
 
 import (
 	"context"
+	"iter"
 	"net/http"
 )
 
@@ -13,6 +14,28 @@ const (
 	VariableTypeNumber VariableType = "number"
 )
 
+// TemplateStatus is the publication status of a template
+type TemplateStatus string
+
+const (
+	TemplateStatusDraft     TemplateStatus = "draft"
+	TemplateStatusPublished TemplateStatus = "published"
+)
+
+// IsValid reports whether the TemplateStatus is one of the known values
+func (s TemplateStatus) IsValid() bool {
+	switch s {
+	case TemplateStatusDraft, TemplateStatusPublished:
+		return true
+	}
+	return false
+}
+
+// String returns the string representation of the TemplateStatus
+func (s TemplateStatus) String() string {
+	return string(s)
+}
+
 // TemplateVariable represents a variable in a template
 // Important:
 // - All variables used in the template HTML (e.g., {{{NAME}}}) must be declared in the Variables array
@@ -40,6 +63,8 @@ type CreateTemplateRequest struct {
 type CreateTemplateResponse struct {
 	Id     string `json:"id"`
 	Object string `json:"object"`
+
+	unknownFields
 }
 
 // UpdateTemplateRequest is the request payload for updating a template
@@ -60,18 +85,24 @@ type UpdateTemplateRequest struct {
 type UpdateTemplateResponse struct {
 	Id     string `json:"id"`
 	Object string `json:"object"`
+
+	unknownFields
 }
 
 // PublishTemplateResponse is the response from publishing a template
 type PublishTemplateResponse struct {
 	Id     string `json:"id"`
 	Object string `json:"object"`
+
+	unknownFields
 }
 
 // DuplicateTemplateResponse is the response from duplicating a template
 type DuplicateTemplateResponse struct {
 	Id     string `json:"id"`
 	Object string `json:"object"`
+
+	unknownFields
 }
 
 // RemoveTemplateResponse is the response from removing a template
@@ -79,17 +110,21 @@ type RemoveTemplateResponse struct {
 	Object  string `json:"object"`
 	Id      string `json:"id"`
 	Deleted bool   `json:"deleted"`
+
+	unknownFields
 }
 
 // TemplateListItem represents a template in a list response
 type TemplateListItem struct {
-	Id          string  `json:"id"`
-	Name        string  `json:"name"`
-	Status      string  `json:"status"`
-	PublishedAt *string `json:"published_at"`
-	CreatedAt   string  `json:"created_at"`
-	UpdatedAt   string  `json:"updated_at"`
-	Alias       string  `json:"alias"`
+	Id          string         `json:"id"`
+	Name        string         `json:"name"`
+	Status      TemplateStatus `json:"status"`
+	PublishedAt *string        `json:"published_at"`
+	CreatedAt   string         `json:"created_at"`
+	UpdatedAt   string         `json:"updated_at"`
+	Alias       string         `json:"alias"`
+
+	unknownFields
 }
 
 // ListTemplatesResponse is the response from listing templates
@@ -97,6 +132,8 @@ type ListTemplatesResponse struct {
 	Object  string              `json:"object"`
 	Data    []*TemplateListItem `json:"data"`
 	HasMore bool                `json:"has_more"`
+
+	unknownFields
 }
 
 // TemplateVariableResponse represents a variable in a template response (with additional fields)
@@ -107,6 +144,8 @@ type TemplateVariableResponse struct {
 	FallbackValue any          `json:"fallback_value"`
 	CreatedAt     string       `json:"created_at"`
 	UpdatedAt     string       `json:"updated_at"`
+
+	unknownFields
 }
 
 // Template represents a full template object returned by the Get endpoint
@@ -117,7 +156,7 @@ type Template struct {
 	Name        string                      `json:"name"`
 	CreatedAt   string                      `json:"created_at"`
 	UpdatedAt   string                      `json:"updated_at"`
-	Status      string                      `json:"status"`
+	Status      TemplateStatus              `json:"status"`
 	PublishedAt string                      `json:"published_at"`
 	From        string                      `json:"from"`
 	Subject     string                      `json:"subject"`
@@ -125,6 +164,8 @@ type Template struct {
 	Html        string                      `json:"html"`
 	Text        string                      `json:"text"`
 	Variables   []*TemplateVariableResponse `json:"variables"`
+
+	unknownFields
 }
 
 // TemplatesSvc handles operations for templates
@@ -135,6 +176,7 @@ type TemplatesSvc interface {
 	Get(identifier string) (*Template, error)
 	ListWithContext(ctx context.Context, options *ListOptions) (*ListTemplatesResponse, error)
 	List(options *ListOptions) (*ListTemplatesResponse, error)
+	All(ctx context.Context, options *ListOptions) iter.Seq2[*TemplateListItem, error]
 	UpdateWithContext(ctx context.Context, identifier string, params *UpdateTemplateRequest) (*UpdateTemplateResponse, error)
 	Update(identifier string, params *UpdateTemplateRequest) (*UpdateTemplateResponse, error)
 	PublishWithContext(ctx context.Context, identifier string) (*PublishTemplateResponse, error)
@@ -240,6 +282,19 @@ func (s *TemplatesSvcImpl) List(options *ListOptions) (*ListTemplatesResponse, e
 	return s.ListWithContext(context.Background(), options)
 }
 
+// All iterates over all templates, fetching pages lazily
+// https://resend.com/docs/api-reference/templates/list-templates
+func (s *TemplatesSvcImpl) All(ctx context.Context, options *ListOptions) iter.Seq2[*TemplateListItem, error] {
+	return paginate(ctx, listCursor(options), func(item *TemplateListItem) string { return item.Id },
+		func(ctx context.Context, cursor ListOptions) ([]*TemplateListItem, bool, error) {
+			resp, err := s.ListWithContext(ctx, &cursor)
+			if err != nil {
+				return nil, false, err
+			}
+			return resp.Data, resp.HasMore, nil
+		})
+}
+
 // UpdateWithContext updates a template by ID or alias
 // https://resend.com/docs/api-reference/templates/update-template
 func (s *TemplatesSvcImpl) UpdateWithContext(ctx context.Context, identifier string, params *UpdateTemplateRequest) (*UpdateTemplateResponse, error) {
@@ -341,7 +341,7 @@ func TestGetTemplate(t *testing.T) {
 	assert.Equal(t, "34a080c9-b17d-4187-ad80-5af20266e535", resp.Id)
 	assert.Equal(t, "reset-password", resp.Alias)
 	assert.Equal(t, "reset-password", resp.Name)
-	assert.Equal(t, "published", resp.Status)
+	assert.Equal(t, TemplateStatusPublished, resp.Status)
 	assert.Equal(t, "2023-10-06 23:47:56.678+00", resp.CreatedAt)
 	assert.Equal(t, "2023-10-06 23:47:56.678+00", resp.UpdatedAt)
 	assert.Equal(t, "2023-10-06 23:47:56.678+00", resp.PublishedAt)
@@ -398,7 +398,7 @@ func TestGetTemplateByAlias(t *testing.T) {
 	assert.Equal(t, "template-id-123", resp.Id)
 	assert.Equal(t, "welcome-email", resp.Alias)
 	assert.Equal(t, "Welcome Email", resp.Name)
-	assert.Equal(t, "draft", resp.Status)
+	assert.Equal(t, TemplateStatusDraft, resp.Status)
 	assert.Equal(t, "", resp.PublishedAt)
 	assert.Equal(t, "support@example.com", resp.From)
 	assert.Equal(t, "Welcome!", resp.Subject)
@@ -445,7 +445,7 @@ func TestGetTemplateWithContext(t *testing.T) {
 	assert.Equal(t, "template", resp.Object)
 	assert.Equal(t, "context-test-id", resp.Id)
 	assert.Equal(t, "Context Test", resp.Name)
-	assert.Equal(t, "published", resp.Status)
+	assert.Equal(t, TemplateStatusPublished, resp.Status)
 }
 
 func TestListTemplates(t *testing.T) {
@@ -501,12 +501,12 @@ func TestListTemplates(t *testing.T) {
 	assert.Equal(t, 2, len(resp.Data))
 	assert.Equal(t, "e169aa45-1ecf-4183-9955-b1499d5701d3", resp.Data[0].Id)
 	assert.Equal(t, "reset-password", resp.Data[0].Name)
-	assert.Equal(t, "draft", resp.Data[0].Status)
+	assert.Equal(t, TemplateStatusDraft, resp.Data[0].Status)
 	assert.Nil(t, resp.Data[0].PublishedAt)
 	assert.Equal(t, "reset-password", resp.Data[0].Alias)
 	assert.Equal(t, "b7f9c2e1-1234-4abc-9def-567890abcdef", resp.Data[1].Id)
 	assert.Equal(t, "welcome-message", resp.Data[1].Name)
-	assert.Equal(t, "published", resp.Data[1].Status)
+	assert.Equal(t, TemplateStatusPublished, resp.Data[1].Status)
 	assert.NotNil(t, resp.Data[1].PublishedAt)
 	assert.Equal(t, "2023-10-06 23:47:56.678+00", *resp.Data[1].PublishedAt)
 }
@@ -0,0 +1,367 @@
+package resend
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// timeLayouts are the layouts of the timestamps returned by the API, tried
+// in order
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999-07",
+	"2006-01-02T15:04:05.999999999-07",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+}
+
+// Timestamp is a time returned by the API. It unmarshals every format used
+// by the API, ie: "2024-08-05T11:52:01.858Z" or "2024-08-05 11:52:01.858+00",
+// and marshals back to the exact value it was decoded from. It is returned
+// by the time accessors of the models, ie: Email.CreatedTime.
+type Timestamp struct {
+	time.Time
+
+	// raw is the value the timestamp was parsed from
+	raw string
+}
+
+// NewTimestamp returns the Timestamp of t
+func NewTimestamp(t time.Time) Timestamp {
+	return Timestamp{Time: t}
+}
+
+// ParseTimestamp parses a timestamp returned by the API. An empty string is
+// parsed as the zero Timestamp.
+func ParseTimestamp(s string) (Timestamp, error) {
+	if s == "" {
+		return Timestamp{}, nil
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return Timestamp{Time: t, raw: s}, nil
+		}
+	}
+	return Timestamp{}, fmt.Errorf("[ERROR]: invalid timestamp %q", s)
+}
+
+// ParseTime parses a timestamp returned by the API, see ParseTimestamp
+func ParseTime(s string) (time.Time, error) {
+	t, err := ParseTimestamp(s)
+	return t.Time, err
+}
+
+// String returns the value the timestamp was parsed from, or the time
+// formatted as RFC 3339
+func (t Timestamp) String() string {
+	if t.raw != "" {
+		return t.raw
+	}
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+// MarshalJSON implements json.Marshaler. The zero Timestamp is encoded as null.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.raw == "" && t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. null and empty strings are
+// decoded as the zero Timestamp.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*t = Timestamp{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("[ERROR]: invalid timestamp %s", data)
+	}
+	parsed, err := ParseTimestamp(s)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// parseOptionalTimestamp parses a nullable timestamp, nil is the zero Timestamp
+func parseOptionalTimestamp(s *string) (Timestamp, error) {
+	if s == nil {
+		return Timestamp{}, nil
+	}
+	return ParseTimestamp(*s)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (a ApiKey) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(a.CreatedAt)
+}
+
+// LastUsedTime returns the parsed LastUsedAt, zero when it is not set
+func (a ApiKey) LastUsedTime() (Timestamp, error) {
+	return parseOptionalTimestamp(a.LastUsedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (a AutomationListItem) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(a.CreatedAt)
+}
+
+// UpdatedTime returns the parsed UpdatedAt
+func (a AutomationListItem) UpdatedTime() (Timestamp, error) {
+	return ParseTimestamp(a.UpdatedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (a Automation) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(a.CreatedAt)
+}
+
+// UpdatedTime returns the parsed UpdatedAt
+func (a Automation) UpdatedTime() (Timestamp, error) {
+	return ParseTimestamp(a.UpdatedAt)
+}
+
+// StartedTime returns the parsed StartedAt, zero when it is not set
+func (a AutomationRunListItem) StartedTime() (Timestamp, error) {
+	return parseOptionalTimestamp(a.StartedAt)
+}
+
+// CompletedTime returns the parsed CompletedAt, zero when it is not set
+func (a AutomationRunListItem) CompletedTime() (Timestamp, error) {
+	return parseOptionalTimestamp(a.CompletedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (a AutomationRunListItem) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(a.CreatedAt)
+}
+
+// StartedTime returns the parsed StartedAt, zero when it is not set
+func (a AutomationRunStep) StartedTime() (Timestamp, error) {
+	return parseOptionalTimestamp(a.StartedAt)
+}
+
+// CompletedTime returns the parsed CompletedAt, zero when it is not set
+func (a AutomationRunStep) CompletedTime() (Timestamp, error) {
+	return parseOptionalTimestamp(a.CompletedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (a AutomationRunStep) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(a.CreatedAt)
+}
+
+// StartedTime returns the parsed StartedAt, zero when it is not set
+func (a AutomationRun) StartedTime() (Timestamp, error) {
+	return parseOptionalTimestamp(a.StartedAt)
+}
+
+// CompletedTime returns the parsed CompletedAt, zero when it is not set
+func (a AutomationRun) CompletedTime() (Timestamp, error) {
+	return parseOptionalTimestamp(a.CompletedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (a AutomationRun) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(a.CreatedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (b Broadcast) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(b.CreatedAt)
+}
+
+// ScheduledTime returns the parsed ScheduledAt, zero when it is not set
+func (b Broadcast) ScheduledTime() (Timestamp, error) {
+	return ParseTimestamp(b.ScheduledAt)
+}
+
+// SentTime returns the parsed SentAt, zero when it is not set
+func (b Broadcast) SentTime() (Timestamp, error) {
+	return ParseTimestamp(b.SentAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (c ContactImport) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(c.CreatedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (c ContactProperty) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(c.CreatedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (c Contact) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(c.CreatedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (d DomainClaim) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(d.CreatedAt)
+}
+
+// ExpiresTime returns the parsed ExpiresAt
+func (d DomainClaim) ExpiresTime() (Timestamp, error) {
+	return ParseTimestamp(d.ExpiresAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (c CreateDomainResponse) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(c.CreatedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (d Domain) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(d.CreatedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (e Email) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(e.CreatedAt)
+}
+
+// ScheduledTime returns the parsed ScheduledAt, zero when it is not set
+func (e Email) ScheduledTime() (Timestamp, error) {
+	return ParseTimestamp(e.ScheduledAt)
+}
+
+// ExpiresTime returns the parsed ExpiresAt
+func (e EmailAttachment) ExpiresTime() (Timestamp, error) {
+	return ParseTimestamp(e.ExpiresAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (e EventSummary) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(e.CreatedAt)
+}
+
+// UpdatedTime returns the parsed UpdatedAt, zero when it is not set
+func (e EventSummary) UpdatedTime() (Timestamp, error) {
+	return parseOptionalTimestamp(e.UpdatedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (e Event) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(e.CreatedAt)
+}
+
+// UpdatedTime returns the parsed UpdatedAt, zero when it is not set
+func (e Event) UpdatedTime() (Timestamp, error) {
+	return parseOptionalTimestamp(e.UpdatedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (l Log) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(l.CreatedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (o OAuthGrant) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(o.CreatedAt)
+}
+
+// RevokedTime returns the parsed RevokedAt, zero when it is not set
+func (o OAuthGrant) RevokedTime() (Timestamp, error) {
+	return parseOptionalTimestamp(o.RevokedAt)
+}
+
+// RevokedTime returns the parsed RevokedAt, zero when it is not set
+func (r RevokeOAuthGrantResponse) RevokedTime() (Timestamp, error) {
+	return parseOptionalTimestamp(r.RevokedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (r ReceivedEmail) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(r.CreatedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (l ListReceivedEmail) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(l.CreatedAt)
+}
+
+// ExpiresTime returns the parsed ExpiresAt
+func (r RawEmail) ExpiresTime() (Timestamp, error) {
+	return ParseTimestamp(r.ExpiresAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (s Segment) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(s.CreatedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (s SuppressionListEntry) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(s.CreatedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (s Suppression) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(s.CreatedAt)
+}
+
+// PublishedTime returns the parsed PublishedAt, zero when it is not set
+func (t TemplateListItem) PublishedTime() (Timestamp, error) {
+	return parseOptionalTimestamp(t.PublishedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (t TemplateListItem) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(t.CreatedAt)
+}
+
+// UpdatedTime returns the parsed UpdatedAt
+func (t TemplateListItem) UpdatedTime() (Timestamp, error) {
+	return ParseTimestamp(t.UpdatedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (t TemplateVariableResponse) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(t.CreatedAt)
+}
+
+// UpdatedTime returns the parsed UpdatedAt
+func (t TemplateVariableResponse) UpdatedTime() (Timestamp, error) {
+	return ParseTimestamp(t.UpdatedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (t Template) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(t.CreatedAt)
+}
+
+// UpdatedTime returns the parsed UpdatedAt
+func (t Template) UpdatedTime() (Timestamp, error) {
+	return ParseTimestamp(t.UpdatedAt)
+}
+
+// PublishedTime returns the parsed PublishedAt, zero when it is not set
+func (t Template) PublishedTime() (Timestamp, error) {
+	return ParseTimestamp(t.PublishedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (t Topic) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(t.CreatedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (w Webhook) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(w.CreatedAt)
+}
+
+// CreatedTime returns the parsed CreatedAt
+func (w WebhookInList) CreatedTime() (Timestamp, error) {
+	return ParseTimestamp(w.CreatedAt)
+}
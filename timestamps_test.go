@@ -0,0 +1,112 @@
+package resend
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTime(t *testing.T) {
+	want := time.Date(2024, 8, 5, 11, 52, 1, 858000000, time.UTC)
+	for _, s := range []string{
+		"2024-08-05T11:52:01.858Z",
+		"2024-08-05T11:52:01.858+00:00",
+		"2024-08-05 11:52:01.858+00",
+		"2024-08-05 11:52:01.858+00:00",
+		"2024-08-05 13:52:01.858+02",
+		"2024-08-05 11:52:01.858",
+	} {
+		got, err := ParseTime(s)
+		assert.NoError(t, err, s)
+		assert.True(t, want.Equal(got), s)
+	}
+
+	got, err := ParseTime("2024-08-05 11:52:01+00")
+	assert.NoError(t, err)
+	assert.True(t, want.Truncate(time.Second).Equal(got))
+
+	got, err = ParseTime("")
+	assert.NoError(t, err)
+	assert.True(t, got.IsZero())
+
+	_, err = ParseTime("tomorrow")
+	assert.Error(t, err)
+}
+
+func TestTimestampJSON(t *testing.T) {
+	var v struct {
+		CreatedAt Timestamp  `json:"created_at"`
+		SentAt    Timestamp  `json:"sent_at"`
+		UpdatedAt *Timestamp `json:"updated_at"`
+	}
+	data := `{"created_at":"2024-08-05 11:52:01.858+00","sent_at":null,"updated_at":"2024-08-05T11:52:01.858Z"}`
+	assert.NoError(t, json.Unmarshal([]byte(data), &v))
+
+	assert.True(t, time.Date(2024, 8, 5, 11, 52, 1, 858000000, time.UTC).Equal(v.CreatedAt.Time))
+	assert.True(t, v.CreatedAt.Equal(v.UpdatedAt.Time))
+	assert.True(t, v.SentAt.IsZero())
+	assert.Equal(t, "2024-08-05 11:52:01.858+00", v.CreatedAt.String())
+
+	// the timestamps are encoded back to the exact values they were decoded from
+	out, err := json.Marshal(v)
+	assert.NoError(t, err)
+	assert.JSONEq(t, data, string(out))
+
+	out, err = json.Marshal(NewTimestamp(time.Date(2024, 8, 5, 11, 52, 1, 0, time.UTC)))
+	assert.NoError(t, err)
+	assert.Equal(t, `"2024-08-05T11:52:01Z"`, string(out))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"created_at":"yesterday"}`), &v))
+	assert.Error(t, json.Unmarshal([]byte(`{"created_at":42}`), &v))
+}
+
+func TestTimestampAccessors(t *testing.T) {
+	want := time.Date(2024, 8, 5, 11, 52, 1, 858000000, time.UTC)
+	raw := "2024-08-05 11:52:01.858+00"
+
+	created, err := Email{CreatedAt: raw}.CreatedTime()
+	assert.NoError(t, err)
+	assert.True(t, want.Equal(created.Time))
+	assert.Equal(t, raw, created.String())
+
+	b := Broadcast{ScheduledAt: raw}
+	scheduled, err := b.ScheduledTime()
+	assert.NoError(t, err)
+	assert.True(t, want.Equal(scheduled.Time))
+	sent, err := b.SentTime()
+	assert.NoError(t, err)
+	assert.True(t, sent.IsZero())
+
+	lastUsed, err := ApiKey{LastUsedAt: &raw}.LastUsedTime()
+	assert.NoError(t, err)
+	assert.True(t, want.Equal(lastUsed.Time))
+	lastUsed, err = ApiKey{}.LastUsedTime()
+	assert.NoError(t, err)
+	assert.True(t, lastUsed.IsZero())
+
+	_, err = DomainClaim{ExpiresAt: "soon"}.ExpiresTime()
+	assert.Error(t, err)
+}
+
+func TestCreateDomainResponseCreatedAt(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/domains", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"1","name":"example.com","created_at":"2023-03-28 17:12:02.059593+00","records":[{"record":"SPF"}]}`))
+	})
+
+	resp, err := client.Domains.Create(&CreateDomainRequest{Name: "example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, "2023-03-28 17:12:02.059593+00", resp.CreatedAt)
+	assert.Len(t, resp.Records, 1)
+
+	created, err := resp.CreatedTime()
+	assert.NoError(t, err)
+	assert.Equal(t, 2023, created.Year())
+}
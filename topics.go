@@ -2,6 +2,7 @@ package resend
 
 import (
 	"context"
+	"iter"
 	"net/http"
 )
 
@@ -13,6 +14,16 @@ const (
 	DefaultSubscriptionOptOut DefaultSubscription = "opt_out"
 )
 
+// IsValid reports whether the DefaultSubscription is one of the known values
+func (s DefaultSubscription) IsValid() bool {
+	return s == DefaultSubscriptionOptIn || s == DefaultSubscriptionOptOut
+}
+
+// String returns the string representation of the DefaultSubscription
+func (s DefaultSubscription) String() string {
+	return string(s)
+}
+
 // CreateTopicRequest is the request payload for creating a topic
 type CreateTopicRequest struct {
 	Name                string              `json:"name"`
@@ -23,6 +34,8 @@ type CreateTopicRequest struct {
 // CreateTopicResponse is the response from creating a topic
 type CreateTopicResponse struct {
 	Id string `json:"id"`
+
+	unknownFields
 }
 
 // Topic represents a full topic object
@@ -32,6 +45,8 @@ type Topic struct {
 	Description         string              `json:"description"`
 	DefaultSubscription DefaultSubscription `json:"default_subscription"`
 	CreatedAt           string              `json:"created_at"`
+
+	unknownFields
 }
 
 // UpdateTopicRequest is the request payload for updating a topic
@@ -44,6 +59,8 @@ type UpdateTopicRequest struct {
 // UpdateTopicResponse is the response from updating a topic
 type UpdateTopicResponse struct {
 	Id string `json:"id"`
+
+	unknownFields
 }
 
 // RemoveTopicResponse is the response from removing a topic
@@ -51,6 +68,8 @@ type RemoveTopicResponse struct {
 	Object  string `json:"object"`
 	Id      string `json:"id"`
 	Deleted bool   `json:"deleted"`
+
+	unknownFields
 }
 
 // ListTopicsResponse is the response from listing topics
@@ -58,6 +77,8 @@ type ListTopicsResponse struct {
 	Object  string   `json:"object"`
 	HasMore bool     `json:"has_more"`
 	Data    []*Topic `json:"data"`
+
+	unknownFields
 }
 
 // TopicsSvc handles operations for topics
@@ -68,6 +89,7 @@ type TopicsSvc interface {
 	Get(topicId string) (*Topic, error)
 	ListWithContext(ctx context.Context, options *ListOptions) (*ListTopicsResponse, error)
 	List(options *ListOptions) (*ListTopicsResponse, error)
+	All(ctx context.Context, options *ListOptions) iter.Seq2[*Topic, error]
 	UpdateWithContext(ctx context.Context, topicId string, params *UpdateTopicRequest) (*UpdateTopicResponse, error)
 	Update(topicId string, params *UpdateTopicRequest) (*UpdateTopicResponse, error)
 	RemoveWithContext(ctx context.Context, topicId string) (*RemoveTopicResponse, error)
@@ -169,6 +191,19 @@ func (s *TopicsSvcImpl) List(options *ListOptions) (*ListTopicsResponse, error)
 	return s.ListWithContext(context.Background(), options)
 }
 
+// All iterates over all topics, fetching pages lazily
+// https://resend.com/docs/api-reference/topics/list-topics
+func (s *TopicsSvcImpl) All(ctx context.Context, options *ListOptions) iter.Seq2[*Topic, error] {
+	return paginate(ctx, listCursor(options), func(item *Topic) string { return item.Id },
+		func(ctx context.Context, cursor ListOptions) ([]*Topic, bool, error) {
+			resp, err := s.ListWithContext(ctx, &cursor)
+			if err != nil {
+				return nil, false, err
+			}
+			return resp.Data, resp.HasMore, nil
+		})
+}
+
 // UpdateWithContext updates a topic by ID
 // https://resend.com/docs/api-reference/topics/update-topic
 func (s *TopicsSvcImpl) UpdateWithContext(ctx context.Context, topicId string, params *UpdateTopicRequest) (*UpdateTopicResponse, error) {
@@ -0,0 +1,324 @@
+package resend
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// ListUnsubscribeHeader is the header holding the unsubscribe URL
+	ListUnsubscribeHeader = "List-Unsubscribe"
+
+	// ListUnsubscribePostHeader is the header enabling one-click unsubscribe, RFC 8058
+	ListUnsubscribePostHeader = "List-Unsubscribe-Post"
+
+	// ListUnsubscribeOneClick is the value of the List-Unsubscribe-Post header,
+	// also sent as the body of one-click unsubscribe requests
+	ListUnsubscribeOneClick = "List-Unsubscribe=One-Click"
+
+	// DefaultUnsubscribeTTL is how long unsubscribe URLs stay valid by default
+	DefaultUnsubscribeTTL = 90 * 24 * time.Hour
+)
+
+// ErrInvalidUnsubscribeLink is returned when an unsubscribe URL is
+// malformed, tampered with or expired
+var ErrInvalidUnsubscribeLink = errors.New("[ERROR]: Invalid or expired unsubscribe link")
+
+// UnsubscribeOptions configures an Unsubscriber
+type UnsubscribeOptions struct {
+	// Secret signs the unsubscribe URLs. It must be kept private and stable
+	// across deployments, or the URLs of the emails already sent stop working.
+	Secret string
+
+	// BaseURL is the URL the Unsubscriber is served at, ie:
+	// "https://example.com/unsubscribe"
+	BaseURL string
+
+	// TTL is how long the URLs stay valid, DefaultUnsubscribeTTL by default
+	TTL time.Duration
+
+	// Mailto is an optional address added to the List-Unsubscribe header, for
+	// the clients not supporting one-click unsubscribe
+	Mailto string
+
+	// ConfirmHandler, when set, serves the GET requests with a valid URL
+	// instead of the default confirmation form. It must not unsubscribe the
+	// contact: links are fetched by some security scanners. Its page should
+	// instead submit a POST request to the same URL.
+	ConfirmHandler http.Handler
+
+	// OnUnsubscribe is called after a contact is unsubscribed by the handler
+	OnUnsubscribe func(r *http.Request, u Unsubscription)
+
+	// OnError is called when the handler fails to unsubscribe a contact
+	OnError func(r *http.Request, err error)
+}
+
+// Unsubscription is the unsubscribe request carried by a signed URL
+type Unsubscription struct {
+	// Contact is the id or email of the contact
+	Contact string
+
+	// TopicId is the topic the contact is unsubscribed from. The contact is
+	// unsubscribed from every email when empty.
+	TopicId string
+
+	// ExpiresAt is the time the URL stops being valid
+	ExpiresAt time.Time
+}
+
+// Unsubscriber generates signed, expiring one-click unsubscribe URLs and
+// serves them as an http.Handler:
+//
+//	unsub, err := resend.NewUnsubscriber(client, &resend.UnsubscribeOptions{
+//		Secret:  os.Getenv("UNSUBSCRIBE_SECRET"),
+//		BaseURL: "https://example.com/unsubscribe",
+//	})
+//	http.Handle("/unsubscribe", unsub)
+//
+//	err = unsub.AddHeaders(params, contactId, topicId)
+type Unsubscriber struct {
+	contacts ContactsSvc
+	topics   ContactTopicsSvc
+	secret   []byte
+	baseURL  *url.URL
+	options  UnsubscribeOptions
+
+	// now is the clock of the Unsubscriber, replaced in tests
+	now func() time.Time
+}
+
+// NewUnsubscriber returns an Unsubscriber applying the unsubscribe
+// requests with the contacts of the client
+func NewUnsubscriber(client *Client, options *UnsubscribeOptions) (*Unsubscriber, error) {
+	if client == nil {
+		return nil, errors.New("[ERROR]: client cannot be nil")
+	}
+	if options == nil || options.Secret == "" {
+		return nil, errors.New("[ERROR]: Secret cannot be empty")
+	}
+
+	baseURL, err := url.Parse(options.BaseURL)
+	if err != nil || !baseURL.IsAbs() {
+		return nil, fmt.Errorf("[ERROR]: BaseURL must be an absolute URL: %q", options.BaseURL)
+	}
+
+	u := &Unsubscriber{
+		contacts: client.Contacts,
+		secret:   []byte(options.Secret),
+		baseURL:  baseURL,
+		options:  *options,
+		now:      time.Now,
+	}
+	if client.Contacts != nil {
+		u.topics = client.Contacts.Topics
+	}
+	if u.options.TTL <= 0 {
+		u.options.TTL = DefaultUnsubscribeTTL
+	}
+	return u, nil
+}
+
+// URL returns a signed URL unsubscribing a contact, given by id or email,
+// from a topic, or from every email when topicId is empty. Prefer contact
+// ids: the URL reveals emails to whoever the email is forwarded to.
+func (u *Unsubscriber) URL(contact, topicId string) (string, error) {
+	if contact == "" {
+		return "", errors.New("[ERROR]: contact cannot be empty")
+	}
+
+	expires := strconv.FormatInt(u.now().Add(u.options.TTL).Unix(), 10)
+
+	query := u.baseURL.Query()
+	query.Set("contact", contact)
+	if topicId != "" {
+		query.Set("topic", topicId)
+	}
+	query.Set("expires", expires)
+	query.Set("signature", u.sign(contact, topicId, expires))
+
+	link := *u.baseURL
+	link.RawQuery = query.Encode()
+	return link.String(), nil
+}
+
+// Headers returns the List-Unsubscribe and List-Unsubscribe-Post headers
+// of an email sent to a contact, see URL
+func (u *Unsubscriber) Headers(contact, topicId string) (map[string]string, error) {
+	link, err := u.URL(contact, topicId)
+	if err != nil {
+		return nil, err
+	}
+
+	value := "<" + link + ">"
+	if u.options.Mailto != "" {
+		value = "<mailto:" + u.options.Mailto + "?subject=unsubscribe>, " + value
+	}
+	return map[string]string{
+		ListUnsubscribeHeader:     value,
+		ListUnsubscribePostHeader: ListUnsubscribeOneClick,
+	}, nil
+}
+
+// AddHeaders adds the unsubscribe headers of a contact to an email, keeping
+// its other headers
+func (u *Unsubscriber) AddHeaders(params *SendEmailRequest, contact, topicId string) error {
+	if params == nil {
+		return errors.New("[ERROR]: params cannot be nil")
+	}
+
+	headers, err := u.Headers(contact, topicId)
+	if err != nil {
+		return err
+	}
+	if params.Headers == nil {
+		params.Headers = make(map[string]string, len(headers))
+	}
+	for k, v := range headers {
+		params.Headers[k] = v
+	}
+	return nil
+}
+
+// Verify checks the signature and expiry of the query of an unsubscribe
+// URL. The error matches ErrInvalidUnsubscribeLink.
+func (u *Unsubscriber) Verify(query url.Values) (Unsubscription, error) {
+	contact := query.Get("contact")
+	topicId := query.Get("topic")
+	expires := query.Get("expires")
+	signature := query.Get("signature")
+	if contact == "" || expires == "" || signature == "" {
+		return Unsubscription{}, fmt.Errorf("%w: missing parameters", ErrInvalidUnsubscribeLink)
+	}
+
+	expected := u.sign(contact, topicId, expires)
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(signature)) != 1 {
+		return Unsubscription{}, fmt.Errorf("%w: signature mismatch", ErrInvalidUnsubscribeLink)
+	}
+
+	// The expiry is checked once signed, so it cannot be extended
+	secs, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return Unsubscription{}, fmt.Errorf("%w: invalid expiry", ErrInvalidUnsubscribeLink)
+	}
+	expiresAt := time.Unix(secs, 0)
+	if !u.now().Before(expiresAt) {
+		return Unsubscription{}, fmt.Errorf("%w: expired at %s", ErrInvalidUnsubscribeLink, expiresAt.UTC().Format(time.RFC3339))
+	}
+
+	return Unsubscription{Contact: contact, TopicId: topicId, ExpiresAt: expiresAt}, nil
+}
+
+// Unsubscribe opts a contact out of the topic of the unsubscription, or
+// marks it unsubscribed from every email when there is no topic
+func (u *Unsubscriber) Unsubscribe(ctx context.Context, unsub Unsubscription) error {
+	id, email := unsub.Contact, ""
+	if strings.Contains(id, "@") {
+		id, email = "", unsub.Contact
+	}
+
+	if unsub.TopicId != "" {
+		if u.topics == nil {
+			return errors.New("[ERROR]: Contact topics are not available")
+		}
+		_, err := u.topics.UpdateWithContext(ctx, &UpdateContactTopicsRequest{
+			Id:     id,
+			Email:  email,
+			Topics: []TopicSubscriptionUpdate{{Id: unsub.TopicId, Subscription: TopicSubscriptionOptOut}},
+		})
+		return err
+	}
+
+	if u.contacts == nil {
+		return errors.New("[ERROR]: Contacts are not available")
+	}
+	params := &UpdateContactRequest{Id: id, Email: email}
+	params.SetUnsubscribed(true)
+	_, err := u.contacts.UpdateWithContext(ctx, params)
+	return err
+}
+
+// ServeHTTP verifies the URL of the request and unsubscribes the contact.
+//
+// POST requests are the one-click unsubscribe of RFC 8058, sent by the
+// email clients without user interaction, or the confirmation form
+// submitted from a browser; they unsubscribe the contact. GET requests are
+// the link opened in a browser, or fetched by a link scanner: they only
+// render a form asking to confirm, or call ConfirmHandler when set.
+// Invalid or expired URLs are answered with a 400, and failures of the API
+// with a 502.
+func (u *Unsubscriber) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	unsub, err := u.Verify(r.URL.Query())
+	if err != nil {
+		http.Error(w, "This unsubscribe link is invalid or has expired", http.StatusBadRequest)
+		return
+	}
+
+	if r.Method == http.MethodGet {
+		if u.options.ConfirmHandler != nil {
+			u.options.ConfirmHandler.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		confirmForm.Execute(w, r.URL.RequestURI())
+		return
+	}
+
+	if err := u.Unsubscribe(r.Context(), unsub); err != nil {
+		if u.options.OnError != nil {
+			u.options.OnError(r, err)
+		}
+		http.Error(w, "Failed to unsubscribe, please try again later", http.StatusBadGateway)
+		return
+	}
+	if u.options.OnUnsubscribe != nil {
+		u.options.OnUnsubscribe(r, unsub)
+	}
+
+	// One-click requests expect no content
+	if r.PostFormValue("List-Unsubscribe") == "One-Click" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Write([]byte("You have been unsubscribed.\n"))
+}
+
+// confirmForm is the page answering GET requests, posting back to the
+// unsubscribe URL
+var confirmForm = template.Must(template.New("confirm").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Unsubscribe</title></head>
+<body>
+<form method="post" action="{{.}}">
+<p>Do you want to unsubscribe?</p>
+<button type="submit">Unsubscribe</button>
+</form>
+</body>
+</html>
+`))
+
+// sign returns the signature of the parameters of an unsubscribe URL. They
+// are joined by newlines, which cannot appear in ids and email addresses.
+func (u *Unsubscriber) sign(contact, topicId, expires string) string {
+	h := hmac.New(sha256.New, u.secret)
+	h.Write([]byte(strings.Join([]string{contact, topicId, expires}, "\n")))
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,216 @@
+package resend
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestUnsubscriber(t *testing.T, options *UnsubscribeOptions) *Unsubscriber {
+	opts := UnsubscribeOptions{Secret: "s3cr3t", BaseURL: "https://example.com/unsubscribe?list=news"}
+	if options != nil {
+		opts = *options
+		opts.Secret, opts.BaseURL = "s3cr3t", "https://example.com/unsubscribe?list=news"
+	}
+	u, err := NewUnsubscriber(client, &opts)
+	assert.NoError(t, err)
+	u.now = func() time.Time { return time.Date(2024, 8, 5, 11, 0, 0, 0, time.UTC) }
+	return u
+}
+
+func TestNewUnsubscriberErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := NewUnsubscriber(client, nil)
+	assert.Error(t, err)
+	_, err = NewUnsubscriber(client, &UnsubscribeOptions{Secret: "s3cr3t", BaseURL: "/unsubscribe"})
+	assert.Error(t, err)
+	_, err = NewUnsubscriber(nil, &UnsubscribeOptions{Secret: "s3cr3t", BaseURL: "https://example.com"})
+	assert.Error(t, err)
+}
+
+func TestUnsubscriberHeaders(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := newTestUnsubscriber(t, &UnsubscribeOptions{Mailto: "unsubscribe@example.com"})
+
+	params := &SendEmailRequest{Headers: map[string]string{"X-Entity-Ref-ID": "123"}}
+	assert.NoError(t, u.AddHeaders(params, "c1", "t1"))
+	assert.Equal(t, "123", params.Headers["X-Entity-Ref-ID"])
+	assert.Equal(t, ListUnsubscribeOneClick, params.Headers[ListUnsubscribePostHeader])
+
+	value := params.Headers[ListUnsubscribeHeader]
+	assert.True(t, strings.HasPrefix(value, "<mailto:unsubscribe@example.com?subject=unsubscribe>, <https://example.com/unsubscribe?"), value)
+
+	link, err := url.Parse(strings.TrimSuffix(value[strings.LastIndex(value, "<")+1:], ">"))
+	assert.NoError(t, err)
+	query := link.Query()
+	assert.Equal(t, "news", query.Get("list"))
+	assert.Equal(t, "c1", query.Get("contact"))
+	assert.Equal(t, "t1", query.Get("topic"))
+
+	unsub, err := u.Verify(query)
+	assert.NoError(t, err)
+	assert.Equal(t, Unsubscription{Contact: "c1", TopicId: "t1", ExpiresAt: u.now().Add(DefaultUnsubscribeTTL)}, Unsubscription{Contact: unsub.Contact, TopicId: unsub.TopicId, ExpiresAt: unsub.ExpiresAt.UTC()})
+
+	_, err = u.URL("", "t1")
+	assert.Error(t, err)
+}
+
+func TestUnsubscriberVerify(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := newTestUnsubscriber(t, &UnsubscribeOptions{TTL: time.Hour})
+	link, err := u.URL("jane@example.com", "")
+	assert.NoError(t, err)
+	parsed, _ := url.Parse(link)
+	valid := parsed.Query()
+
+	tampered := func(key, value string) url.Values {
+		query := url.Values{}
+		for k, v := range valid {
+			query[k] = v
+		}
+		query.Set(key, value)
+		return query
+	}
+
+	for name, query := range map[string]url.Values{
+		"contact":   tampered("contact", "john@example.com"),
+		"topic":     tampered("topic", "t1"),
+		"expires":   tampered("expires", "99999999999"),
+		"signature": tampered("signature", ""),
+	} {
+		_, err := u.Verify(query)
+		assert.True(t, errors.Is(err, ErrInvalidUnsubscribeLink), name)
+	}
+
+	other := newTestUnsubscriber(t, nil)
+	other.secret = []byte("other")
+	_, err = other.Verify(valid)
+	assert.ErrorIs(t, err, ErrInvalidUnsubscribeLink)
+
+	_, err = u.Verify(valid)
+	assert.NoError(t, err)
+	u.now = func() time.Time { return time.Date(2024, 8, 5, 12, 0, 0, 0, time.UTC) }
+	_, err = u.Verify(valid)
+	assert.ErrorIs(t, err, ErrInvalidUnsubscribeLink)
+	assert.Contains(t, err.Error(), "expired at 2024-08-05T12:00:00Z")
+}
+
+func TestUnsubscriberServeHTTPTopic(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/contacts/c1/topics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		var body []TopicSubscriptionUpdate
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []TopicSubscriptionUpdate{{Id: "t1", Subscription: TopicSubscriptionOptOut}}, body)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"c1"}`))
+	})
+
+	var unsubscribed []Unsubscription
+	u := newTestUnsubscriber(t, &UnsubscribeOptions{
+		OnUnsubscribe: func(r *http.Request, unsub Unsubscription) { unsubscribed = append(unsubscribed, unsub) },
+	})
+	link, err := u.URL("c1", "t1")
+	assert.NoError(t, err)
+
+	// One-click unsubscribe, RFC 8058
+	req := httptest.NewRequest(http.MethodPost, link, strings.NewReader(ListUnsubscribeOneClick))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	u.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Body.String())
+	assert.Len(t, unsubscribed, 1)
+	assert.Equal(t, "t1", unsubscribed[0].TopicId)
+
+	// Link opened in a browser, or fetched by a link scanner
+	rec = httptest.NewRecorder()
+	u.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, link, nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/html; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Body.String(), `<form method="post" action="/unsubscribe?`)
+	assert.Len(t, unsubscribed, 1)
+
+	// Confirmation form submitted
+	action := strings.ReplaceAll(regexp.MustCompile(`action="([^"]+)"`).FindStringSubmatch(rec.Body.String())[1], "&amp;", "&")
+	rec = httptest.NewRecorder()
+	u.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, action, nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "unsubscribed")
+	assert.Len(t, unsubscribed, 2)
+
+	rec = httptest.NewRecorder()
+	u.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, link, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	rec = httptest.NewRecorder()
+	u.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, strings.Replace(link, "c1", "c2", 1), nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Len(t, unsubscribed, 2)
+}
+
+func TestUnsubscriberServeHTTPContact(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/contacts/jane@example.com", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		calls++
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, true, body["unsubscribed"])
+		assert.Equal(t, "jane@example.com", body["email"])
+
+		w.Header().Set("Content-Type", "application/json")
+		if calls > 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"name":"internal_server_error","message":"Something went wrong"}`))
+			return
+		}
+		w.Write([]byte(`{"object":"contact","id":"c1"}`))
+	})
+
+	var failures []error
+	confirmed := 0
+	u := newTestUnsubscriber(t, &UnsubscribeOptions{
+		ConfirmHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { confirmed++ }),
+		OnError:        func(r *http.Request, err error) { failures = append(failures, err) },
+	})
+	link, err := u.URL("jane@example.com", "")
+	assert.NoError(t, err)
+
+	// GET requests are confirmed first
+	rec := httptest.NewRecorder()
+	u.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, link, nil))
+	assert.Equal(t, 1, confirmed)
+	assert.Equal(t, 0, calls)
+
+	rec = httptest.NewRecorder()
+	u.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, link, nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, 1, calls)
+
+	rec = httptest.NewRecorder()
+	u.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, link, nil))
+	assert.Equal(t, http.StatusBadGateway, rec.Code)
+	assert.Len(t, failures, 1)
+	assert.ErrorIs(t, failures[0], ErrServer)
+}
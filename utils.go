@@ -6,6 +6,9 @@ import "os"
 // ie: []byte(`hello`) becomes []int{104,101,108,108,111}
 // which will then be properly marshalled into JSON
 // in the way Resend supports
+//
+// Deprecated: Attachment content is now sent as a base64 encoded string,
+// which is smaller and can be streamed. Kept for backwards compatibility.
 func BytesToIntArray(a []byte) []int {
 	res := make([]int, len(a))
 	for i, v := range a {
@@ -0,0 +1,228 @@
+package resend
+
+import (
+	"fmt"
+	"io/fs"
+	"net/mail"
+	"regexp"
+	"strings"
+)
+
+const (
+	// MaxRecipients is the maximum number of addresses of the To, Cc and
+	// Bcc fields of an email
+	MaxRecipients = 50
+
+	// MaxBatchSize is the maximum number of emails of a batch
+	MaxBatchSize = 100
+
+	// MaxAttachmentsSize is the maximum size of the attachments of an
+	// email, once base64 encoded
+	MaxAttachmentsSize = 40 << 20
+
+	// maxTagLength is the maximum length of tag names and values
+	maxTagLength = 256
+)
+
+// tagPattern matches the characters allowed in tag names and values
+var tagPattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// FieldError is a problem with a field of a request
+type FieldError struct {
+	// Field is the JSON path of the field, ie: "to" or "emails[2].tags[0].name"
+	Field string
+
+	// Message describes the problem
+	Message string
+}
+
+// ValidationError is returned when a request fails client-side validation,
+// before it is sent to the API. It lists every problem found and matches
+// ErrValidation with errors.Is.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	problems := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		problems[i] = f.Field + ": " + f.Message
+	}
+	return "[ERROR]: Invalid request: " + strings.Join(problems, "; ")
+}
+
+// Is implements errors.Is support for matching ErrValidation
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// add records a problem with a field
+func (e *ValidationError) add(field, format string, args ...any) {
+	e.Fields = append(e.Fields, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// err returns e if it holds problems, nil otherwise
+func (e *ValidationError) err() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+	return e
+}
+
+// Validate checks the request for the mistakes the API would reject it for,
+// ie: malformed addresses, too many recipients, invalid tags, conflicting
+// content fields or oversized attachments. It returns a *ValidationError
+// listing every problem, or nil.
+func (r *SendEmailRequest) Validate() error {
+	e := &ValidationError{}
+	if r == nil {
+		e.add("params", "cannot be nil")
+		return e
+	}
+	r.validate(e, "")
+	return e.err()
+}
+
+// ValidateBatch checks a batch of emails the way Validate does, and
+// reports the fields that cannot be used in a batch, ie: ScheduledAt.
+func ValidateBatch(params []*SendEmailRequest) error {
+	e := &ValidationError{}
+	if len(params) == 0 {
+		e.add("emails", "at least one email is required")
+	}
+	if len(params) > MaxBatchSize {
+		e.add("emails", "at most %d emails can be sent in a batch, got %d", MaxBatchSize, len(params))
+	}
+	for i, r := range params {
+		prefix := fmt.Sprintf("emails[%d].", i)
+		if r == nil {
+			e.add(prefix[:len(prefix)-1], "email is nil")
+			continue
+		}
+		r.validate(e, prefix)
+		if r.ScheduledAt != "" {
+			e.add(prefix+"scheduled_at", "scheduling is not supported in a batch")
+		}
+		if len(r.Attachments) > 0 {
+			e.add(prefix+"attachments", "attachments are not supported in a batch")
+		}
+	}
+	return e.err()
+}
+
+// validate records the problems of the request, prefixing the field names
+func (r *SendEmailRequest) validate(e *ValidationError, prefix string) {
+	if r.From == "" {
+		e.add(prefix+"from", "is required")
+	} else if _, err := mail.ParseAddress(r.From); err != nil {
+		e.add(prefix+"from", "invalid address %q, use \"email@example.com\" or \"Name <email@example.com>\"", r.From)
+	}
+
+	if len(r.To) == 0 {
+		e.add(prefix+"to", "at least one recipient is required")
+	}
+	validateRecipients(e, prefix+"to", r.To)
+	validateRecipients(e, prefix+"cc", r.Cc)
+	validateRecipients(e, prefix+"bcc", r.Bcc)
+
+	if r.ReplyTo != "" {
+		if _, err := mail.ParseAddressList(r.ReplyTo); err != nil {
+			e.add(prefix+"reply_to", "invalid address %q", r.ReplyTo)
+		}
+	}
+
+	if r.Template != nil {
+		if r.Template.Id == "" {
+			e.add(prefix+"template.id", "is required")
+		}
+		if r.Html != "" {
+			e.add(prefix+"html", "cannot be used with a template")
+		}
+		if r.Text != "" {
+			e.add(prefix+"text", "cannot be used with a template")
+		}
+	} else if r.Subject == "" {
+		e.add(prefix+"subject", "is required")
+	}
+
+	for i, tag := range r.Tags {
+		field := fmt.Sprintf("%stags[%d]", prefix, i)
+		validateTagPart(e, field+".name", tag.Name, true)
+		validateTagPart(e, field+".value", tag.Value, false)
+	}
+
+	if msg := checkScheduledAtString(r.ScheduledAt); msg != "" {
+		e.add(prefix+"scheduled_at", msg)
+	}
+
+	var size int64
+	for i, a := range r.Attachments {
+		field := fmt.Sprintf("%sattachments[%d]", prefix, i)
+		if a == nil {
+			e.add(field, "attachment is nil")
+			continue
+		}
+		hasContent := len(a.Content) > 0 || a.Reader != nil
+		switch {
+		case !hasContent && a.Path == "":
+			e.add(field, "content, reader or path is required")
+		case hasContent && a.Path != "":
+			e.add(field, "path cannot be used with content")
+		}
+		if hasContent && a.Filename == "" {
+			e.add(field+".filename", "is required")
+		}
+		if n, ok := attachmentSize(a); ok {
+			size += (n + 2) / 3 * 4
+		}
+	}
+	if size > MaxAttachmentsSize {
+		e.add(prefix+"attachments", "attachments exceed %d MB once encoded", MaxAttachmentsSize>>20)
+	}
+}
+
+// validateRecipients records the problems of a recipients field
+func validateRecipients(e *ValidationError, field string, addresses []string) {
+	if len(addresses) > MaxRecipients {
+		e.add(field, "at most %d recipients are allowed, got %d", MaxRecipients, len(addresses))
+	}
+	for i, addr := range addresses {
+		if _, err := mail.ParseAddress(addr); err != nil {
+			e.add(fmt.Sprintf("%s[%d]", field, i), "invalid address %q", addr)
+		}
+	}
+}
+
+// validateTagPart records the problems of a tag name or value
+func validateTagPart(e *ValidationError, field, v string, required bool) {
+	switch {
+	case v == "":
+		if required {
+			e.add(field, "is required")
+		}
+	case len(v) > maxTagLength:
+		e.add(field, "must be at most %d characters", maxTagLength)
+	case !tagPattern.MatchString(v):
+		e.add(field, "must only contain ASCII letters, numbers, underscores or dashes")
+	}
+}
+
+// attachmentSize returns the size of the content of an attachment, when it
+// is known without reading it
+func attachmentSize(a *Attachment) (int64, bool) {
+	if a.Reader == nil {
+		return int64(len(a.Content)), true
+	}
+	switch r := a.Reader.(type) {
+	case interface{ Len() int }:
+		return int64(r.Len()), true
+	case interface{ Stat() (fs.FileInfo, error) }:
+		info, err := r.Stat()
+		if err != nil {
+			return 0, false
+		}
+		return info.Size(), true
+	}
+	return 0, false
+}
@@ -0,0 +1,164 @@
+package resend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validEmail() *SendEmailRequest {
+	return &SendEmailRequest{
+		From:    "Acme <onboarding@resend.dev>",
+		To:      []string{"delivered@resend.dev"},
+		Subject: "Hello",
+		Html:    "<p>Hello</p>",
+	}
+}
+
+func fields(err error) []string {
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		return nil
+	}
+	var names []string
+	for _, f := range validationErr.Fields {
+		names = append(names, f.Field)
+	}
+	return names
+}
+
+func TestValidateSendEmailRequest(t *testing.T) {
+	assert.NoError(t, validEmail().Validate())
+
+	var nilRequest *SendEmailRequest
+	assert.Equal(t, []string{"params"}, fields(nilRequest.Validate()))
+
+	tooMany := make([]string, MaxRecipients+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("user%d@example.com", i)
+	}
+
+	cases := []struct {
+		name   string
+		modify func(r *SendEmailRequest)
+		fields []string
+	}{
+		{"missing from", func(r *SendEmailRequest) { r.From = "" }, []string{"from"}},
+		{"malformed from", func(r *SendEmailRequest) { r.From = "Acme onboarding@resend.dev>" }, []string{"from"}},
+		{"missing to", func(r *SendEmailRequest) { r.To = nil }, []string{"to"}},
+		{"too many recipients", func(r *SendEmailRequest) { r.Bcc = tooMany }, []string{"bcc"}},
+		{"invalid cc", func(r *SendEmailRequest) { r.Cc = []string{"ok@example.com", "nope"} }, []string{"cc[1]"}},
+		{"invalid tags", func(r *SendEmailRequest) {
+			r.Tags = []Tag{{Name: "category", Value: "welcome"}, {Name: "bad name", Value: "é"}, {Value: "x"}}
+		}, []string{"tags[1].name", "tags[1].value", "tags[2].name"}},
+		{"template with html and text", func(r *SendEmailRequest) {
+			r.Template = &EmailTemplate{Id: "welcome"}
+			r.Text = "Hello"
+		}, []string{"html", "text"}},
+		{"template without subject", func(r *SendEmailRequest) {
+			r.Template = &EmailTemplate{Id: "welcome"}
+			r.Html = ""
+			r.Subject = ""
+		}, nil},
+		{"oversized attachments", func(r *SendEmailRequest) {
+			r.Attachments = []*Attachment{
+				{Filename: "a.bin", Content: make([]byte, 20<<20)},
+				{Filename: "b.bin", Reader: strings.NewReader(strings.Repeat("x", 10<<20))},
+			}
+		}, []string{"attachments"}},
+		{"attachment without content", func(r *SendEmailRequest) {
+			r.Attachments = []*Attachment{{Filename: "a.txt"}, {Content: []byte("x")}}
+		}, []string{"attachments[0]", "attachments[1].filename"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := validEmail()
+			tc.modify(r)
+			err := r.Validate()
+			if tc.fields == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, errors.Is(err, ErrValidation))
+			assert.Equal(t, tc.fields, fields(err))
+		})
+	}
+}
+
+func TestValidationErrorMessage(t *testing.T) {
+	err := (&SendEmailRequest{}).Validate()
+	assert.Equal(t, "[ERROR]: Invalid request: from: is required; to: at least one recipient is required; subject: is required", err.Error())
+}
+
+func TestValidateBatch(t *testing.T) {
+	scheduled := validEmail()
+	scheduled.ScheduledAt = "in 1 hour"
+	invalid := validEmail()
+	invalid.To = []string{"nope"}
+
+	err := ValidateBatch([]*SendEmailRequest{validEmail(), scheduled, invalid, nil})
+	assert.Equal(t, []string{"emails[1].scheduled_at", "emails[2].to[0]", "emails[3]"}, fields(err))
+
+	tooMany := make([]*SendEmailRequest, MaxBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = validEmail()
+	}
+	assert.Equal(t, []string{"emails"}, fields(ValidateBatch(tooMany)))
+	assert.NoError(t, ValidateBatch(tooMany[:MaxBatchSize]))
+}
+
+func TestSendWithRequestValidation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/emails", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"1"}`))
+	})
+	mux.HandleFunc("/emails/batch", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[{"id":"1"}]}`))
+	})
+
+	invalid := validEmail()
+	invalid.From = "nope"
+
+	// requests are not validated by default
+	_, err := client.Emails.Send(invalid)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+
+	validating := NewClient("re_test", WithBaseURL(server.URL), WithRequestValidation())
+	assert.True(t, validating.ValidateRequests)
+
+	_, err = validating.Emails.Send(invalid)
+	var validationErr *ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "from", validationErr.Fields[0].Field)
+
+	_, err = validating.Batch.Send([]*SendEmailRequest{invalid})
+	assert.True(t, errors.Is(err, ErrValidation))
+
+	_, err = validating.Emails.Send(nil)
+	assert.True(t, errors.As(err, &validationErr))
+	_, err = validating.Emails.SendWithOptions(context.Background(), nil, nil)
+	assert.True(t, errors.As(err, &validationErr))
+	_, err = validating.Batch.Send([]*SendEmailRequest{validEmail(), nil})
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, 1, calls)
+
+	_, err = validating.Emails.Send(validEmail())
+	assert.NoError(t, err)
+	_, err = validating.Batch.Send([]*SendEmailRequest{validEmail()})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
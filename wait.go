@@ -0,0 +1,135 @@
+package resend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// IsTerminal reports whether no other event is expected after e to know the
+// outcome of the email: delivered, bounced, complained, failed, suppressed
+// or canceled.
+func (e EmailEvent) IsTerminal() bool {
+	switch e {
+	case EmailEventDelivered, EmailEventBounced, EmailEventComplained, EmailEventFailed, EmailEventSuppressed, EmailEventCanceled:
+		return true
+	}
+	return false
+}
+
+// WaitForStatusOptions configures Emails.WaitForStatus
+type WaitForStatusOptions struct {
+	// Events are the events to wait for. Terminal events are waited for
+	// when empty, see EmailEvent.IsTerminal.
+	Events []EmailEvent
+
+	// PollInterval is the delay before the second poll, 1 second by default
+	PollInterval time.Duration
+
+	// MaxPollInterval caps the delay between polls, 30 seconds by default
+	MaxPollInterval time.Duration
+
+	// Multiplier is the growth factor of the delay between polls, 2 by
+	// default. A multiplier of 1 polls at a fixed interval.
+	Multiplier float64
+
+	// Timeout limits the time spent waiting, on top of the deadline of the
+	// context. There is no limit when 0.
+	Timeout time.Duration
+}
+
+// WaitForStatusResponse is the outcome of Emails.WaitForStatus
+type WaitForStatusResponse struct {
+	// Outcome is the event the email reached
+	Outcome EmailEvent
+
+	// Email is the email as last retrieved
+	Email *Email
+
+	// Polls is the number of times the email was retrieved
+	Polls int
+}
+
+// Delivered reports whether the email was delivered
+func (r *WaitForStatusResponse) Delivered() bool {
+	return r.Outcome == EmailEventDelivered
+}
+
+// WaitForStatus polls an email until its last_event is one of the awaited
+// events, with a growing delay between polls. An email not found yet, like
+// right after it was sent, and rate limit errors are polled again; any
+// other error is returned.
+//
+// When the context is done or the timeout expires first, the returned
+// error wraps the context error and names the last event of the email.
+func (s *EmailsSvcImpl) WaitForStatus(ctx context.Context, emailId string, options *WaitForStatusOptions) (*WaitForStatusResponse, error) {
+	if emailId == "" {
+		return nil, errors.New("[ERROR]: emailId cannot be empty")
+	}
+
+	opts := WaitForStatusOptions{}
+	if options != nil {
+		opts = *options
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = time.Second
+	}
+	if opts.MaxPollInterval <= 0 {
+		opts.MaxPollInterval = 30 * time.Second
+	}
+	if opts.Multiplier < 1 {
+		opts.Multiplier = 2
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	awaited := func(event EmailEvent) bool {
+		if len(opts.Events) == 0 {
+			return event.IsTerminal()
+		}
+		return slices.Contains(opts.Events, event)
+	}
+
+	resp := &WaitForStatusResponse{}
+	delay := opts.PollInterval
+	for {
+		email, err := s.GetWithContext(ctx, emailId)
+		resp.Polls++
+		switch {
+		case err == nil:
+			resp.Email = email
+			if awaited(email.LastEvent) {
+				resp.Outcome = email.LastEvent
+				return resp, nil
+			}
+		case ctx.Err() != nil:
+			return nil, waitError(emailId, resp.Email, ctx.Err())
+		case !errors.Is(err, ErrNotFound) && !errors.Is(err, ErrRateLimit):
+			return nil, err
+		}
+
+		if !sleepContext(ctx, delay) {
+			// sleepContext gives up early when the deadline would expire
+			// during the delay, which is as good as expired
+			err := ctx.Err()
+			if err == nil {
+				err = context.DeadlineExceeded
+			}
+			return nil, waitError(emailId, resp.Email, err)
+		}
+		delay = min(time.Duration(float64(delay)*opts.Multiplier), opts.MaxPollInterval)
+	}
+}
+
+// waitError wraps the context error ending WaitForStatus
+func waitError(emailId string, last *Email, err error) error {
+	if last == nil {
+		return fmt.Errorf("[ERROR]: Email %s not found while waiting for its status: %w", emailId, err)
+	}
+	return fmt.Errorf("[ERROR]: Email %s is still %s: %w", emailId, last.LastEvent, err)
+}
@@ -0,0 +1,106 @@
+package resend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// eventsHandler serves the email with the next event of events on every
+// request, answering 404 for the empty ones
+func eventsHandler(t *testing.T, events []EmailEvent, polls *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		event := events[min(*polls, len(events)-1)]
+		*polls++
+
+		w.Header().Set("Content-Type", "application/json")
+		if event == "" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"name":"not_found","message":"Email not found"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(&Email{Id: "1", Object: "email", LastEvent: event})
+	}
+}
+
+func TestEmailEventIsTerminal(t *testing.T) {
+	for _, event := range []EmailEvent{EmailEventDelivered, EmailEventBounced, EmailEventComplained, EmailEventFailed, EmailEventSuppressed, EmailEventCanceled} {
+		assert.True(t, event.IsTerminal(), event)
+	}
+	for _, event := range []EmailEvent{EmailEventQueued, EmailEventScheduled, EmailEventSent, EmailEventDeliveryDelayed, EmailEventOpened, EmailEventClicked} {
+		assert.False(t, event.IsTerminal(), event)
+	}
+}
+
+func TestWaitForStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	polls := 0
+	mux.HandleFunc("/emails/1", eventsHandler(t, []EmailEvent{"", EmailEventSent, EmailEventDeliveryDelayed, EmailEventBounced}, &polls))
+
+	start := time.Now()
+	resp, err := client.Emails.WaitForStatus(context.Background(), "1", &WaitForStatusOptions{PollInterval: time.Millisecond, MaxPollInterval: 2 * time.Millisecond})
+	assert.NoError(t, err)
+	assert.Equal(t, EmailEventBounced, resp.Outcome)
+	assert.False(t, resp.Delivered())
+	assert.Equal(t, "1", resp.Email.Id)
+	assert.Equal(t, 4, resp.Polls)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestWaitForStatusEvents(t *testing.T) {
+	setup()
+	defer teardown()
+
+	polls := 0
+	mux.HandleFunc("/emails/1", eventsHandler(t, []EmailEvent{EmailEventQueued, EmailEventSent, EmailEventDelivered}, &polls))
+
+	resp, err := client.Emails.WaitForStatus(context.Background(), "1", &WaitForStatusOptions{
+		Events:       []EmailEvent{EmailEventSent},
+		PollInterval: time.Millisecond,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, EmailEventSent, resp.Outcome)
+	assert.Equal(t, 2, polls)
+}
+
+func TestWaitForStatusTimeout(t *testing.T) {
+	setup()
+	defer teardown()
+
+	polls := 0
+	mux.HandleFunc("/emails/1", eventsHandler(t, []EmailEvent{EmailEventSent}, &polls))
+
+	_, err := client.Emails.WaitForStatus(context.Background(), "1", &WaitForStatusOptions{
+		PollInterval: 5 * time.Millisecond,
+		Multiplier:   1,
+		Timeout:      50 * time.Millisecond,
+	})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Contains(t, err.Error(), "Email 1 is still sent")
+	assert.Greater(t, polls, 1)
+}
+
+func TestWaitForStatusError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/emails/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"name":"missing_api_key","message":"Missing API key"}`))
+	})
+
+	_, err := client.Emails.WaitForStatus(context.Background(), "1", nil)
+	assert.True(t, errors.Is(err, ErrUnauthorized))
+
+	_, err = client.Emails.WaitForStatus(context.Background(), "", nil)
+	assert.Error(t, err)
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 	"strconv"
 	"strings"
@@ -42,6 +43,28 @@ const (
 // Default tolerance for timestamp validation (5 minutes)
 const DefaultWebhookToleranceSeconds = 300
 
+// WebhookStatus is the status of a webhook
+type WebhookStatus string
+
+const (
+	WebhookStatusEnabled  WebhookStatus = "enabled"
+	WebhookStatusDisabled WebhookStatus = "disabled"
+)
+
+// IsValid reports whether the WebhookStatus is one of the known values
+func (s WebhookStatus) IsValid() bool {
+	switch s {
+	case WebhookStatusEnabled, WebhookStatusDisabled:
+		return true
+	}
+	return false
+}
+
+// String returns the string representation of the WebhookStatus
+func (s WebhookStatus) String() string {
+	return string(s)
+}
+
 // CreateWebhookRequest represents the parameters for creating a webhook
 type CreateWebhookRequest struct {
 	Endpoint string   `json:"endpoint"`
@@ -53,30 +76,36 @@ type CreateWebhookResponse struct {
 	Object        string `json:"object"`
 	Id            string `json:"id"`
 	SigningSecret string `json:"signing_secret"`
+
+	unknownFields
 }
 
 // Webhook represents a webhook object
 type Webhook struct {
-	Object        string   `json:"object"`
-	Id            string   `json:"id"`
-	CreatedAt     string   `json:"created_at,omitempty"`
-	Status        string   `json:"status,omitempty"`
-	Endpoint      string   `json:"endpoint,omitempty"`
-	Events        []string `json:"events,omitempty"`
-	SigningSecret string   `json:"signing_secret,omitempty"`
+	Object        string        `json:"object"`
+	Id            string        `json:"id"`
+	CreatedAt     string        `json:"created_at,omitempty"`
+	Status        WebhookStatus `json:"status,omitempty"`
+	Endpoint      string        `json:"endpoint,omitempty"`
+	Events        []string      `json:"events,omitempty"`
+	SigningSecret string        `json:"signing_secret,omitempty"`
+
+	unknownFields
 }
 
 // UpdateWebhookRequest represents the parameters for updating a webhook
 type UpdateWebhookRequest struct {
-	Endpoint *string  `json:"endpoint,omitempty"`
-	Events   []string `json:"events,omitempty"`
-	Status   *string  `json:"status,omitempty"`
+	Endpoint *string        `json:"endpoint,omitempty"`
+	Events   []string       `json:"events,omitempty"`
+	Status   *WebhookStatus `json:"status,omitempty"`
 }
 
 // UpdateWebhookResponse represents the response from updating a webhook
 type UpdateWebhookResponse struct {
 	Object string `json:"object"`
 	Id     string `json:"id"`
+
+	unknownFields
 }
 
 // ListWebhooksResponse represents the response from listing webhooks
@@ -84,15 +113,19 @@ type ListWebhooksResponse struct {
 	Object  string          `json:"object"`
 	HasMore bool            `json:"has_more"`
 	Data    []WebhookInList `json:"data"`
+
+	unknownFields
 }
 
 // WebhookInList represents a webhook in the list response
 type WebhookInList struct {
-	Id        string   `json:"id"`
-	CreatedAt string   `json:"created_at"`
-	Status    string   `json:"status"`
-	Endpoint  string   `json:"endpoint"`
-	Events    []string `json:"events"`
+	Id        string        `json:"id"`
+	CreatedAt string        `json:"created_at"`
+	Status    WebhookStatus `json:"status"`
+	Endpoint  string        `json:"endpoint"`
+	Events    []string      `json:"events"`
+
+	unknownFields
 }
 
 // DeleteWebhookResponse represents the response from deleting a webhook
@@ -100,6 +133,8 @@ type DeleteWebhookResponse struct {
 	Object  string `json:"object"`
 	Id      string `json:"id"`
 	Deleted bool   `json:"deleted"`
+
+	unknownFields
 }
 
 // WebhookHeaders represents the webhook verification headers
@@ -127,6 +162,7 @@ type WebhooksSvc interface {
 	ListWithOptions(ctx context.Context, options *ListOptions) (*ListWebhooksResponse, error)
 	ListWithContext(ctx context.Context) (*ListWebhooksResponse, error)
 	List() (*ListWebhooksResponse, error)
+	All(ctx context.Context, options *ListOptions) iter.Seq2[WebhookInList, error]
 	RemoveWithContext(ctx context.Context, webhookId string) (*DeleteWebhookResponse, error)
 	Remove(webhookId string) (*DeleteWebhookResponse, error)
 	Verify(options *VerifyWebhookOptions) error
@@ -250,6 +286,19 @@ func (s *WebhooksSvcImpl) List() (*ListWebhooksResponse, error) {
 	return s.ListWithContext(context.Background())
 }
 
+// All iterates over all webhooks, fetching pages lazily
+// https://resend.com/docs/api-reference/webhooks/list-webhooks
+func (s *WebhooksSvcImpl) All(ctx context.Context, options *ListOptions) iter.Seq2[WebhookInList, error] {
+	return paginate(ctx, listCursor(options), func(item WebhookInList) string { return item.Id },
+		func(ctx context.Context, cursor ListOptions) ([]WebhookInList, bool, error) {
+			resp, err := s.ListWithOptions(ctx, &cursor)
+			if err != nil {
+				return nil, false, err
+			}
+			return resp.Data, resp.HasMore, nil
+		})
+}
+
 // RemoveWithContext deletes a webhook by ID with the given context
 // https://resend.com/docs/api-reference/webhooks/delete-webhook
 func (s *WebhooksSvcImpl) RemoveWithContext(ctx context.Context, webhookId string) (*DeleteWebhookResponse, error) {
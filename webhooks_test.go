@@ -103,7 +103,7 @@ func TestGetWebhook(t *testing.T) {
 	assert.Equal(t, "webhook", resp.Object)
 	assert.Equal(t, "4dd369bc-aa82-4ff3-97de-514ae3000ee0", resp.Id)
 	assert.Equal(t, "2023-08-22 15:28:00+00", resp.CreatedAt)
-	assert.Equal(t, "enabled", resp.Status)
+	assert.Equal(t, WebhookStatusEnabled, resp.Status)
 	assert.Equal(t, "https://webhook.example.com/handler", resp.Endpoint)
 	assert.Equal(t, 2, len(resp.Events))
 	assert.Equal(t, "email.sent", resp.Events[0])
@@ -143,7 +143,7 @@ func TestGetWebhookWithContext(t *testing.T) {
 	assert.Equal(t, "webhook", resp.Object)
 	assert.Equal(t, "test-webhook-id", resp.Id)
 	assert.Equal(t, "2024-01-01 00:00:00+00", resp.CreatedAt)
-	assert.Equal(t, "enabled", resp.Status)
+	assert.Equal(t, WebhookStatusEnabled, resp.Status)
 	assert.Equal(t, "https://test.example.com/webhook", resp.Endpoint)
 	assert.Equal(t, 1, len(resp.Events))
 	assert.Equal(t, "email.delivered", resp.Events[0])
@@ -170,7 +170,7 @@ func TestUpdateWebhook(t *testing.T) {
 	})
 
 	endpoint := "https://new-webhook.example.com/handler"
-	status := "enabled"
+	status := WebhookStatusEnabled
 	req := &UpdateWebhookRequest{
 		Endpoint: &endpoint,
 		Events:   []string{"email.sent", "email.delivered"},
@@ -204,7 +204,7 @@ func TestUpdateWebhookWithContext(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	status := "disabled"
+	status := WebhookStatusDisabled
 	req := &UpdateWebhookRequest{
 		Status: &status,
 	}
@@ -257,11 +257,11 @@ func TestListWebhooks(t *testing.T) {
 	assert.Equal(t, false, resp.HasMore)
 	assert.Equal(t, 2, len(resp.Data))
 	assert.Equal(t, "7ab123cd-ef45-6789-abcd-ef0123456789", resp.Data[0].Id)
-	assert.Equal(t, "disabled", resp.Data[0].Status)
+	assert.Equal(t, WebhookStatusDisabled, resp.Data[0].Status)
 	assert.Equal(t, "https://first-webhook.example.com/handler", resp.Data[0].Endpoint)
 	assert.Equal(t, 2, len(resp.Data[0].Events))
 	assert.Equal(t, "4dd369bc-aa82-4ff3-97de-514ae3000ee0", resp.Data[1].Id)
-	assert.Equal(t, "enabled", resp.Data[1].Status)
+	assert.Equal(t, WebhookStatusEnabled, resp.Data[1].Status)
 }
 
 func TestListWebhooksWithOptions(t *testing.T) {